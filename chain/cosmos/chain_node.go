@@ -12,6 +12,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -115,6 +116,7 @@ const (
 	grpcPort    = "9090/tcp"
 	apiPort     = "1317/tcp"
 	privValPort = "1234/tcp"
+	metricsPort = "26660/tcp"
 
 	cometMockRawPort = "22331"
 )
@@ -353,6 +355,13 @@ func (tn *ChainNode) SetTestConfig(ctx context.Context) error {
 
 	c["rpc"] = rpc
 
+	if tn.Chain.Config().EnableTelemetry {
+		instrumentation := make(testutil.Toml)
+		instrumentation["prometheus"] = true
+		instrumentation["prometheus_listen_addr"] = ":26660"
+		c["instrumentation"] = instrumentation
+	}
+
 	if err := testutil.ModifyTomlConfigFile(
 		ctx,
 		tn.logger(),
@@ -384,6 +393,16 @@ func (tn *ChainNode) SetTestConfig(ctx context.Context) error {
 
 	a["api"] = api
 
+	if tn.Chain.Config().EnableTelemetry {
+		telemetry := make(testutil.Toml)
+
+		// Served by the REST API at /metrics?format=prometheus
+		telemetry["enabled"] = true
+		telemetry["prometheus-retention-time"] = 600
+
+		a["telemetry"] = telemetry
+	}
+
 	return testutil.ModifyTomlConfigFile(
 		ctx,
 		tn.logger(),
@@ -1141,6 +1160,9 @@ func (tn *ChainNode) CreateNodeContainer(ctx context.Context) error {
 	for _, port := range chainCfg.ExposeAdditionalPorts {
 		usingPorts[nat.Port(port)] = []nat.PortBinding{}
 	}
+	if chainCfg.EnableTelemetry {
+		usingPorts[nat.Port(metricsPort)] = []nat.PortBinding{}
+	}
 
 	// to prevent port binding conflicts, host port overrides are only exposed on the first validator node.
 	if tn.Validator && tn.Index == 0 && chainCfg.HostPortOverride != nil {
@@ -1155,6 +1177,10 @@ func (tn *ChainNode) CreateNodeContainer(ctx context.Context) error {
 		fmt.Printf("Port Overrides: %v. Using: %v\n", chainCfg.HostPortOverride, usingPorts)
 	}
 
+	if c, ok := tn.Chain.(*CosmosChain); ok {
+		tn.containerLifecycle.SetEventReporter(c.containerEventReporter())
+	}
+
 	return tn.containerLifecycle.CreateContainer(ctx, tn.TestName, tn.NetworkID, tn.Image, usingPorts, tn.Bind(), nil, tn.HostName(), cmd, chainCfg.Env)
 }
 
@@ -1267,6 +1293,43 @@ func (tn *ChainNode) RemoveContainer(ctx context.Context) error {
 	return tn.containerLifecycle.RemoveContainer(ctx)
 }
 
+// StreamLogs follows the node's container logs, starting with lines written at the time of the call.
+// The returned channel is closed once ctx is done or the container stops.
+func (tn *ChainNode) StreamLogs(ctx context.Context) (<-chan dockerutil.LogLine, error) {
+	return tn.containerLifecycle.StreamLogs(ctx, dockerutil.LogStreamOptions{
+		Since:  time.Now(),
+		Follow: true,
+	})
+}
+
+// WaitForLog blocks until the node logs a line matching re, starting from the time of the call,
+// and returns that line. This is useful to wait for concrete events such as "UPGRADE .* NEEDED"
+// rather than sleeping for a number of blocks.
+func (tn *ChainNode) WaitForLog(ctx context.Context, re *regexp.Regexp) (dockerutil.LogLine, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logs, err := tn.StreamLogs(ctx)
+	if err != nil {
+		return dockerutil.LogLine{}, err
+	}
+	return dockerutil.WaitForLog(ctx, logs, re)
+}
+
+// AssertNoLog checks the node's entire log history up to now
+// and returns an error for the first line matching re.
+// Use dockerutil.FatalLogPattern to detect panics and consensus failures.
+func (tn *ChainNode) AssertNoLog(ctx context.Context, re *regexp.Regexp) error {
+	logs, err := tn.containerLifecycle.StreamLogs(ctx, dockerutil.LogStreamOptions{})
+	if err != nil {
+		return err
+	}
+	if err := dockerutil.AssertNoLog(ctx, logs, re); err != nil {
+		return fmt.Errorf("node %s: %w", tn.Name(), err)
+	}
+	return ctx.Err()
+}
+
 // InitValidatorFiles creates the node files and signs a genesis transaction
 func (tn *ChainNode) InitValidatorGenTx(
 	ctx context.Context,
@@ -1386,7 +1449,15 @@ func (tn *ChainNode) Exec(ctx context.Context, cmd []string, env []string) ([]by
 		Env:   env,
 		Binds: tn.Bind(),
 	}
+	startedAt := time.Now()
 	res := job.Run(ctx, cmd, opts)
+	if c, ok := tn.Chain.(*CosmosChain); ok && c.execReporter != nil {
+		c.execReporter.TrackChainExec(
+			tn.Chain.Config().ChainID, tn.Name(), cmd,
+			string(res.Stdout), string(res.Stderr), res.ExitCode,
+			startedAt, time.Now(), res.Err,
+		)
+	}
 	return res.Stdout, res.Stderr, res.Err
 }
 
@@ -1477,3 +1548,30 @@ func (tn *ChainNode) GetHostAddress(ctx context.Context, portID string) (string,
 	}
 	return "http://" + ports[0], nil
 }
+
+// MetricsAddress returns the host-accessible URL of the node's CometBFT Prometheus metrics endpoint.
+// The chain must be configured with ibc.ChainConfig.EnableTelemetry.
+func (tn *ChainNode) MetricsAddress(ctx context.Context) (string, error) {
+	if !tn.Chain.Config().EnableTelemetry {
+		return "", fmt.Errorf("telemetry is not enabled for chain %s", tn.Chain.Config().ChainID)
+	}
+	addr, err := tn.GetHostAddress(ctx, metricsPort)
+	if err != nil {
+		return "", err
+	}
+	return addr + "/metrics", nil
+}
+
+// AppMetricsAddress returns the host-accessible URL of the node's cosmos-sdk telemetry,
+// in the Prometheus exposition format.
+// The chain must be configured with ibc.ChainConfig.EnableTelemetry.
+func (tn *ChainNode) AppMetricsAddress(ctx context.Context) (string, error) {
+	if !tn.Chain.Config().EnableTelemetry {
+		return "", fmt.Errorf("telemetry is not enabled for chain %s", tn.Chain.Config().ChainID)
+	}
+	addr, err := tn.GetHostAddress(ctx, apiPort)
+	if err != nil {
+		return "", err
+	}
+	return addr + "/metrics?format=prometheus", nil
+}
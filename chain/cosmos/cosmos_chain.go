@@ -28,6 +28,7 @@ import (
 	chanTypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ccvclient "github.com/cosmos/interchain-security/v5/x/ccv/provider/client"
 	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
 	volumetypes "github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
@@ -59,6 +60,9 @@ type CosmosChain struct {
 	// Additional processes that need to be run on a per-chain basis.
 	Sidecars SidecarProcesses
 
+	// If set, receives every command executed against the chain's nodes.
+	execReporter ibc.ChainExecReporter
+
 	cdc      *codec.ProtoCodec
 	log      *zap.Logger
 	keyring  keyring.Keyring
@@ -128,6 +132,21 @@ func (c *CosmosChain) WithPreStartNodes(preStartNodes func(*CosmosChain)) {
 	c.preStartNodes = preStartNodes
 }
 
+// WithExecReporter sets the reporter that tracks every command executed against the chain's nodes.
+// If rep also satisfies dockerutil.ContainerEventReporter,
+// lifecycle events of node and sidecar containers are reported as well.
+func (c *CosmosChain) WithExecReporter(rep ibc.ChainExecReporter) {
+	c.execReporter = rep
+}
+
+// containerEventReporter returns the chain's exec reporter
+// if it can also track container lifecycle events.
+func (c *CosmosChain) containerEventReporter() dockerutil.ContainerEventReporter {
+	if rep, ok := c.execReporter.(dockerutil.ContainerEventReporter); ok {
+		return rep
+	}
+	return nil
+}
 
 // GetCodec returns the codec for the chain.
 func (c *CosmosChain) GetCodec() *codec.ProtoCodec {
@@ -364,8 +383,13 @@ func (c *CosmosChain) SendIBCTransfer(
 	if txResp.Code != 0 {
 		return tx, fmt.Errorf("error in transaction (code: %d): %s", txResp.Code, txResp.RawLog)
 	}
+	return packetTx(txResp)
+}
+
+// packetTx returns the details of txResp, which must have sent a single IBC packet.
+func packetTx(txResp *types.TxResponse) (tx ibc.Tx, _ error) {
 	tx.Height = txResp.Height
-	tx.TxHash = txHash
+	tx.TxHash = txResp.TxHash
 	// In cosmos, user is charged for entire gas requested, not the actual gas used.
 	tx.GasSpent = txResp.GasWanted
 
@@ -1185,6 +1209,70 @@ func (c *CosmosChain) StartAllNodes(ctx context.Context) error {
 	return eg.Wait()
 }
 
+// Resume starts the existing nodes of a chain that was started with testName by another process
+// and stopped without removing its containers or volumes.
+// The containers are reused, so the chain keeps its state and its nodes keep their host ports.
+// Chains with sidecar processes cannot be resumed.
+func (c *CosmosChain) Resume(ctx context.Context, testName string, cli *client.Client, networkID string) error {
+	chainCfg := c.Config()
+	if len(chainCfg.SidecarConfigs) > 0 || chainCfg.UsesCometMock() {
+		return fmt.Errorf("resuming chain %s: chains with sidecar processes cannot be resumed", chainCfg.ChainID)
+	}
+	image := chainCfg.Images[0]
+
+	attach := func(validator bool, index int) (*ChainNode, error) {
+		tn := NewChainNode(c.log, validator, c, cli, networkID, testName, image, index)
+
+		vols, err := cli.VolumeList(ctx, volumetypes.ListOptions{
+			Filters: filters.NewArgs(filters.Arg("label", dockerutil.NodeOwnerLabel+"="+tn.Name())),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing volumes of %s: %w", tn.Name(), err)
+		}
+		if len(vols.Volumes) != 1 {
+			return nil, fmt.Errorf("expected 1 volume for %s, found %d", tn.Name(), len(vols.Volumes))
+		}
+		tn.VolumeName = vols.Volumes[0].Name
+
+		if err := tn.containerLifecycle.AttachContainer(ctx); err != nil {
+			return nil, err
+		}
+		tn.containerLifecycle.SetEventReporter(c.containerEventReporter())
+		return tn, nil
+	}
+
+	vals := make(ChainNodes, c.NumValidators)
+	for i := range vals {
+		tn, err := attach(true, i)
+		if err != nil {
+			return err
+		}
+		vals[i] = tn
+	}
+	fullNodes := make(ChainNodes, c.numFullNodes)
+	for i := range fullNodes {
+		tn, err := attach(false, i)
+		if err != nil {
+			return err
+		}
+		fullNodes[i] = tn
+	}
+
+	c.findTxMu.Lock()
+	c.Validators = vals
+	c.FullNodes = fullNodes
+	c.findTxMu.Unlock()
+
+	var eg errgroup.Group
+	for _, n := range c.Nodes() {
+		n := n
+		eg.Go(func() error {
+			return n.StartContainer(ctx)
+		})
+	}
+	return eg.Wait()
+}
+
 // StartAllSidecars creates and starts new containers for each sidecar process.
 // Should only be used if the chain has previously been started with .Start.
 func (c *CosmosChain) StartAllSidecars(ctx context.Context) error {
@@ -0,0 +1,72 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// IBCChannelUpgradeInitMsg returns the message initializing an upgrade of the given channel end to fields.
+// The message is signed by the gov module, so it must be submitted in a proposal; see BuildProposal.
+// Relayers then complete the handshake through ibc.Relayer.UpgradeChannel.
+func (c *CosmosChain) IBCChannelUpgradeInitMsg(ctx context.Context, portID, channelID string, fields chantypes.UpgradeFields) (*chantypes.MsgChannelUpgradeInit, error) {
+	authority, err := c.AuthQueryModuleAddress(ctx, "gov")
+	if err != nil {
+		return nil, err
+	}
+	return chantypes.NewMsgChannelUpgradeInit(portID, channelID, fields, authority), nil
+}
+
+// IBCQueryChannel returns the channel end with the given port and channel identifiers.
+func (c *CosmosChain) IBCQueryChannel(ctx context.Context, portID, channelID string) (*chantypes.Channel, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).Channel(ctx, &chantypes.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Channel, nil
+}
+
+// IBCQueryChannelUpgrade returns the upgrade in progress on the given channel end.
+func (c *CosmosChain) IBCQueryChannelUpgrade(ctx context.Context, portID, channelID string) (*chantypes.Upgrade, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).Upgrade(ctx, &chantypes.QueryUpgradeRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Upgrade, nil
+}
+
+// IBCQueryChannelUpgradeError returns the error receipt of the last failed upgrade of the given channel end.
+func (c *CosmosChain) IBCQueryChannelUpgradeError(ctx context.Context, portID, channelID string) (*chantypes.ErrorReceipt, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).UpgradeError(ctx, &chantypes.QueryUpgradeErrorRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.ErrorReceipt, nil
+}
+
+// ackResult returns the result of a successful channel acknowledgement,
+// or an error holding the message of an error acknowledgement.
+func ackResult(ack []byte) ([]byte, error) {
+	var channelAck struct {
+		Result []byte `json:"result"`
+		Error  string `json:"error"`
+	}
+	if err := json.Unmarshal(ack, &channelAck); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal acknowledgement: %w", err)
+	}
+	if channelAck.Error != "" {
+		return nil, fmt.Errorf("error acknowledgement: %s", channelAck.Error)
+	}
+	return channelAck.Result, nil
+}
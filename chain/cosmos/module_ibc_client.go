@@ -0,0 +1,339 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// IBCQueryClientState returns the state of the light client with the given ID.
+func (c *CosmosChain) IBCQueryClientState(ctx context.Context, clientID string) (ibcexported.ClientState, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ClientState(ctx, &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var cs ibcexported.ClientState
+	if err := c.GetCodec().UnpackAny(res.ClientState, &cs); err != nil {
+		return nil, fmt.Errorf("failed to unpack client state of %s: %w", clientID, err)
+	}
+	return cs, nil
+}
+
+// IBCQueryClientStatus returns the status of the light client with the given ID,
+// i.e. ibcexported.Active, ibcexported.Expired or ibcexported.Frozen.
+func (c *CosmosChain) IBCQueryClientStatus(ctx context.Context, clientID string) (ibcexported.Status, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return ibcexported.Status(res.Status), nil
+}
+
+// IBCQueryConsensusState returns the consensus state stored by the light client with the given ID at height.
+func (c *CosmosChain) IBCQueryConsensusState(ctx context.Context, clientID string, height clienttypes.Height) (ibcexported.ConsensusState, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:       clientID,
+		RevisionNumber: height.RevisionNumber,
+		RevisionHeight: height.RevisionHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var cs ibcexported.ConsensusState
+	if err := c.GetCodec().UnpackAny(res.ConsensusState, &cs); err != nil {
+		return nil, fmt.Errorf("failed to unpack consensus state of %s at %s: %w", clientID, height, err)
+	}
+	return cs, nil
+}
+
+// IBCQueryConsensusStateHeights returns the heights of every consensus state stored by the light client with the given ID.
+func (c *CosmosChain) IBCQueryConsensusStateHeights(ctx context.Context, clientID string) ([]clienttypes.Height, error) {
+	qc := clienttypes.NewQueryClient(c.GetNode().GrpcConn)
+	var (
+		heights []clienttypes.Height
+		next    []byte
+	)
+	for {
+		res, err := qc.ConsensusStateHeights(ctx, &clienttypes.QueryConsensusStateHeightsRequest{
+			ClientId:   clientID,
+			Pagination: &query.PageRequest{Key: next},
+		})
+		if err != nil {
+			return nil, err
+		}
+		heights = append(heights, res.ConsensusStateHeights...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return heights, nil
+		}
+		next = res.Pagination.NextKey
+	}
+}
+
+// IBCClientExpiry returns the time at which the tendermint light client with the given ID expires
+// unless it is updated, i.e. the timestamp of its latest consensus state plus its trusting period.
+// The client expires once the block time of c, not the local clock, passes the returned time.
+func (c *CosmosChain) IBCClientExpiry(ctx context.Context, clientID string) (time.Time, error) {
+	cs, err := c.IBCQueryClientState(ctx, clientID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tmClientState, ok := cs.(*ibctm.ClientState)
+	if !ok {
+		return time.Time{}, fmt.Errorf("client %s is not a tendermint client: %s", clientID, cs.ClientType())
+	}
+
+	consState, err := c.IBCQueryConsensusState(ctx, clientID, tmClientState.LatestHeight)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tmConsState, ok := consState.(*ibctm.ConsensusState)
+	if !ok {
+		return time.Time{}, fmt.Errorf("unexpected consensus state type %T of client %s", consState, clientID)
+	}
+	return tmConsState.Timestamp.Add(tmClientState.TrustingPeriod), nil
+}
+
+// WaitForClientExpiry waits until the tendermint light client with the given ID expires.
+// When the chain runs on CometMock its block time is advanced past the expiry;
+// otherwise this sleeps until the expiry, which may take the whole trusting period.
+// The relayer must not update the client meanwhile.
+func (c *CosmosChain) WaitForClientExpiry(ctx context.Context, clientID string) error {
+	expiry, err := c.IBCClientExpiry(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	status, err := c.getFullNode().Client.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block time: %w", err)
+	}
+	// Leave a margin for the block carrying the new time.
+	d := expiry.Sub(status.SyncInfo.LatestBlockTime) + time.Second
+
+	if d > 0 {
+		if c.Config().UsesCometMock() {
+			if err := c.CometMockAdvanceTime(ctx, d); err != nil {
+				return err
+			}
+		} else {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d):
+			}
+		}
+	}
+
+	h, err := c.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get height: %w", err)
+	}
+	return PollForClientStatus(ctx, c, h, h+10, clientID, ibcexported.Expired)
+}
+
+// CometMockAdvanceTime moves the block time of a chain running on CometMock forward by d,
+// rounded up to the second, e.g. to expire light clients or unbonding periods without waiting.
+func (c *CosmosChain) CometMockAdvanceTime(ctx context.Context, d time.Duration) error {
+	if !c.Config().UsesCometMock() {
+		return fmt.Errorf("chain %s does not use CometMock", c.Config().ChainID)
+	}
+
+	// With CometMock, the node's RPC address points at CometMock.
+	cl, err := jsonrpcclient.New("tcp://" + c.getFullNode().hostRPCPort)
+	if err != nil {
+		return fmt.Errorf("failed to create CometMock client: %w", err)
+	}
+	seconds := strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+	res := map[string]any{}
+	if _, err := cl.Call(ctx, "advance_time", map[string]any{"duration_in_seconds": seconds}, &res); err != nil {
+		return fmt.Errorf("failed to advance CometMock time by %ss: %w", seconds, err)
+	}
+	return nil
+}
+
+// IBCRecoverClientMsg returns the governance message replacing the expired or frozen light client subjectClientID
+// with the state of the active client substituteClientID, which must track the same chain.
+// Submit it in a proposal; see BuildProposal.
+func (c *CosmosChain) IBCRecoverClientMsg(ctx context.Context, subjectClientID, substituteClientID string) (*clienttypes.MsgRecoverClient, error) {
+	authority, err := c.AuthQueryModuleAddress(ctx, "gov")
+	if err != nil {
+		return nil, err
+	}
+	return clienttypes.NewMsgRecoverClient(authority, subjectClientID, substituteClientID), nil
+}
+
+// IBCMisbehaviour returns misbehaviour evidence that c equivocated at height, for freezing the tendermint
+// light client with the given ID on the counterparty chain; see IBCSubmitMisbehaviour.
+// The evidence pairs the header committed at height with a conflicting header signed by the keys of c's validators.
+// trustedHeight must be a consensus state height of the client, such as its latest height, below height.
+func (c *CosmosChain) IBCMisbehaviour(ctx context.Context, clientID string, trustedHeight clienttypes.Height, height int64) (*ibctm.Misbehaviour, error) {
+	header, err := c.ibcHeader(ctx, trustedHeight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// The conflicting header differs from the committed one only by its time.
+	signedHeader, err := cmttypes.SignedHeaderFromProto(header.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+	valSet, err := cmttypes.ValidatorSetFromProto(header.ValidatorSet)
+	if err != nil {
+		return nil, err
+	}
+	conflicting := *signedHeader.Header
+	conflicting.Time = conflicting.Time.Add(time.Second)
+
+	commit, err := c.signCommit(ctx, &conflicting, signedHeader.Commit, valSet)
+	if err != nil {
+		return nil, err
+	}
+
+	conflictingHeader := &ibctm.Header{
+		SignedHeader:      &cmtproto.SignedHeader{Header: conflicting.ToProto(), Commit: commit.ToProto()},
+		ValidatorSet:      header.ValidatorSet,
+		TrustedHeight:     header.TrustedHeight,
+		TrustedValidators: header.TrustedValidators,
+	}
+	return ibctm.NewMisbehaviour(clientID, header, conflictingHeader), nil
+}
+
+// IBCSubmitMisbehaviour submits misbehaviour evidence, such as built by IBCMisbehaviour on the counterparty chain,
+// to the light client with the given ID, freezing it.
+func (tn *ChainNode) IBCSubmitMisbehaviour(ctx context.Context, keyName, clientID string, misbehaviour ibcexported.ClientMessage) (string, error) {
+	content, err := tn.Chain.Config().EncodingConfig.Codec.MarshalInterfaceJSON(misbehaviour)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal misbehaviour: %w", err)
+	}
+
+	fileName := "misbehaviour_" + clientID + ".json"
+	if err := tn.WriteFile(ctx, content, fileName); err != nil {
+		return "", fmt.Errorf("failed to write misbehaviour file: %w", err)
+	}
+	return tn.ExecTx(ctx, keyName, "ibc", "client", "update", clientID, path.Join(tn.HomeDir(), fileName))
+}
+
+// ibcHeader returns the tendermint light client header of c at height, trusting the validators at trustedHeight.
+func (c *CosmosChain) ibcHeader(ctx context.Context, trustedHeight clienttypes.Height, height int64) (*ibctm.Header, error) {
+	node := c.getFullNode()
+	commit, err := node.Client.Commit(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit at height %d: %w", height, err)
+	}
+	valSet, err := c.validatorSet(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	// The validators of the trusted consensus state sign the block following it.
+	trustedValSet, err := c.validatorSet(ctx, int64(trustedHeight.RevisionHeight)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	valSetProto, err := valSet.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	trustedValSetProto, err := trustedValSet.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &ibctm.Header{
+		SignedHeader:      commit.SignedHeader.ToProto(),
+		ValidatorSet:      valSetProto,
+		TrustedHeight:     trustedHeight,
+		TrustedValidators: trustedValSetProto,
+	}, nil
+}
+
+// validatorSet returns the validator set of c at height.
+func (c *CosmosChain) validatorSet(ctx context.Context, height int64) (*cmttypes.ValidatorSet, error) {
+	var (
+		vals    []*cmttypes.Validator
+		page    = 1
+		perPage = 100
+	)
+	for {
+		res, err := c.getFullNode().Client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validators at height %d: %w", height, err)
+		}
+		vals = append(vals, res.Validators...)
+		if len(vals) >= res.Total {
+			return cmttypes.NewValidatorSet(vals), nil
+		}
+		page++
+	}
+}
+
+// signCommit returns a commit of header signed with the keys of c's validators in valSet.
+// Validators whose keys are not known to c are recorded as absent.
+func (c *CosmosChain) signCommit(ctx context.Context, header *cmttypes.Header, orig *cmttypes.Commit, valSet *cmttypes.ValidatorSet) (*cmttypes.Commit, error) {
+	keys := make(map[string]privval.FilePVKey, len(c.Validators))
+	for _, v := range c.Validators {
+		bz, err := v.ReadFile(ctx, "config/priv_validator_key.json")
+		if err != nil {
+			return nil, fmt.Errorf("getting priv_validator_key.json content: %w", err)
+		}
+		var pvKey privval.FilePVKey
+		if err := cmtjson.Unmarshal(bz, &pvKey); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal priv_validator_key.json: %w", err)
+		}
+		keys[pvKey.Address.String()] = pvKey
+	}
+
+	blockID := cmttypes.BlockID{Hash: header.Hash(), PartSetHeader: orig.BlockID.PartSetHeader}
+	sigs := make([]cmttypes.CommitSig, valSet.Size())
+	for i, val := range valSet.Validators {
+		pvKey, ok := keys[val.Address.String()]
+		if !ok {
+			sigs[i] = cmttypes.NewCommitSigAbsent()
+			continue
+		}
+
+		vote := &cmttypes.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           header.Height,
+			Round:            orig.Round,
+			BlockID:          blockID,
+			Timestamp:        header.Time,
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+		sig, err := pvKey.PrivKey.Sign(cmttypes.VoteSignBytes(header.ChainID, vote.ToProto()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign vote of validator %s: %w", val.Address, err)
+		}
+		sigs[i] = cmttypes.CommitSig{
+			BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        vote.Timestamp,
+			Signature:        sig,
+		}
+	}
+
+	return &cmttypes.Commit{
+		Height:     header.Height,
+		Round:      orig.Round,
+		BlockID:    blockID,
+		Signatures: sigs,
+	}, nil
+}
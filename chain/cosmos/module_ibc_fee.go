@@ -0,0 +1,115 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// IBCFeeRegisterPayee registers payee to receive the ICS-29 fees earned by relayerAddr on the given channel,
+// instead of relayerAddr itself. The transaction must be signed by the relayer's key.
+func (tn *ChainNode) IBCFeeRegisterPayee(ctx context.Context, keyName, portID, channelID, relayerAddr, payee string) error {
+	_, err := tn.ExecTx(ctx, keyName, "ibc-fee", "register-payee", portID, channelID, relayerAddr, payee)
+	return err
+}
+
+// IBCFeeRegisterCounterpartyPayee registers counterpartyPayee, an address on the counterparty chain,
+// to receive the recv fees earned by relayerAddr on the given channel.
+// The transaction must be signed by the relayer's key.
+func (tn *ChainNode) IBCFeeRegisterCounterpartyPayee(ctx context.Context, keyName, portID, channelID, relayerAddr, counterpartyPayee string) error {
+	_, err := tn.ExecTx(ctx, keyName, "ibc-fee", "register-counterparty-payee", portID, channelID, relayerAddr, counterpartyPayee)
+	return err
+}
+
+// IBCFeePayPacketFee incentivizes relaying the packet with the given sequence, sent on the given channel,
+// escrowing fee until the packet is acknowledged or times out.
+func (tn *ChainNode) IBCFeePayPacketFee(ctx context.Context, keyName, portID, channelID string, sequence uint64, fee feetypes.Fee) (string, error) {
+	cmd := []string{"ibc-fee", "pay-packet-fee", portID, channelID, fmt.Sprint(sequence)}
+	if !fee.RecvFee.Empty() {
+		cmd = append(cmd, "--recv-fee", fee.RecvFee.String())
+	}
+	if !fee.AckFee.Empty() {
+		cmd = append(cmd, "--ack-fee", fee.AckFee.String())
+	}
+	if !fee.TimeoutFee.Empty() {
+		cmd = append(cmd, "--timeout-fee", fee.TimeoutFee.String())
+	}
+	return tn.ExecTx(ctx, keyName, cmd...)
+}
+
+// IBCFeeQueryFeeEnabledChannel reports whether the given channel is wrapped by the fee middleware.
+func (c *CosmosChain) IBCFeeQueryFeeEnabledChannel(ctx context.Context, portID, channelID string) (bool, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).FeeEnabledChannel(ctx, &feetypes.QueryFeeEnabledChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.FeeEnabled, nil
+}
+
+// IBCFeeQueryPayee returns the payee registered for relayerAddr on the given channel.
+func (c *CosmosChain) IBCFeeQueryPayee(ctx context.Context, channelID, relayerAddr string) (string, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).Payee(ctx, &feetypes.QueryPayeeRequest{
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.PayeeAddress, nil
+}
+
+// IBCFeeQueryCounterpartyPayee returns the counterparty payee registered for relayerAddr on the given channel.
+func (c *CosmosChain) IBCFeeQueryCounterpartyPayee(ctx context.Context, channelID, relayerAddr string) (string, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).CounterpartyPayee(ctx, &feetypes.QueryCounterpartyPayeeRequest{
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.CounterpartyPayee, nil
+}
+
+// IBCFeeQueryIncentivizedPacketsForChannel returns the fees escrowed for packets sent on the given channel
+// which have not yet been acknowledged or timed out.
+func (c *CosmosChain) IBCFeeQueryIncentivizedPacketsForChannel(ctx context.Context, portID, channelID string) ([]*feetypes.IdentifiedPacketFees, error) {
+	qc := feetypes.NewQueryClient(c.GetNode().GrpcConn)
+
+	var (
+		packets []*feetypes.IdentifiedPacketFees
+		next    []byte
+	)
+	for {
+		res, err := qc.IncentivizedPacketsForChannel(ctx, &feetypes.QueryIncentivizedPacketsForChannelRequest{
+			PortId:     portID,
+			ChannelId:  channelID,
+			Pagination: &query.PageRequest{Key: next},
+		})
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, res.IncentivizedPackets...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return packets, nil
+		}
+		next = res.Pagination.NextKey
+	}
+}
+
+// IBCFeeQueryTotalRecvFees returns the recv fees escrowed for the given packet.
+func (c *CosmosChain) IBCFeeQueryTotalRecvFees(ctx context.Context, portID, channelID string, sequence uint64) (sdk.Coins, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).TotalRecvFees(ctx, &feetypes.QueryTotalRecvFeesRequest{
+		PacketId: chantypes.NewPacketID(portID, channelID, sequence),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.RecvFees, nil
+}
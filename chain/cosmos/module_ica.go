@@ -0,0 +1,207 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// ICAHostGenesis returns the genesis values enabling the interchain accounts host module
+// to execute the given message types, e.g. "/cosmos.bank.v1beta1.MsgSend", or "*" for any message.
+// Pass the result to ModifyGenesis.
+func ICAHostGenesis(allowMessages ...string) []GenesisKV {
+	return []GenesisKV{
+		NewGenesisKV("app_state.interchainaccounts.host_genesis_state.params.host_enabled", true),
+		NewGenesisKV("app_state.interchainaccounts.host_genesis_state.params.allow_messages", allowMessages),
+	}
+}
+
+// NewICAPacketData returns the packet data executing msgs on an interchain account, encoded with the proto3 encoding.
+func NewICAPacketData(registry codectypes.InterfaceRegistry, msgs []sdk.Msg, memo string) (icatypes.InterchainAccountPacketData, error) {
+	data, err := icatypes.SerializeCosmosTx(codec.NewProtoCodec(registry), msgs, icatypes.EncodingProtobuf)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+	return packetData, packetData.ValidateBasic()
+}
+
+// ICADecodeAck decodes the acknowledgement of an interchain accounts packet into the responses
+// of the executed messages, in order, e.g. a *banktypes.MsgSendResponse for a MsgSend.
+// registry must know every response type, such as the chain's EncodingConfig.InterfaceRegistry of the host chain.
+// An error acknowledgement, such as for a message not allowed by the host, is returned as an error.
+func ICADecodeAck(registry codectypes.InterfaceRegistry, ack []byte) ([]sdk.Msg, error) {
+	result, err := ackResult(ack)
+	if err != nil {
+		return nil, err
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := txMsgData.Unmarshal(result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal interchain accounts acknowledgement: %w", err)
+	}
+
+	cdc := codec.NewProtoCodec(registry)
+	responses := make([]sdk.Msg, len(txMsgData.MsgResponses))
+	for i, a := range txMsgData.MsgResponses {
+		res, err := registry.Resolve(a.TypeUrl)
+		if err != nil {
+			return nil, fmt.Errorf("unknown response type %s: %w", a.TypeUrl, err)
+		}
+		if err := cdc.Unmarshal(a.Value, res); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response %s: %w", a.TypeUrl, err)
+		}
+		responses[i] = res
+	}
+	return responses, nil
+}
+
+// RegisterICAWithOrdering registers an interchain account on the given connection
+// over a channel with the given ordering, e.g. ibc.Unordered to avoid channel closure on timeouts.
+func (tn *ChainNode) RegisterICAWithOrdering(ctx context.Context, keyName, connectionID string, ordering ibc.Order) (string, error) {
+	return tn.ExecTx(ctx, keyName,
+		"interchain-accounts", "controller", "register", connectionID,
+		"--ordering", icaOrdering(ordering).String(),
+	)
+}
+
+// ICAQueryChannels returns the interchain accounts channels of owner on the given connection, in creation order.
+// An ordered channel is closed when one of its packets times out; see RecoverICA.
+func (c *CosmosChain) ICAQueryChannels(ctx context.Context, connectionID, owner string) ([]*chantypes.IdentifiedChannel, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	qc := chantypes.NewQueryClient(c.GetNode().GrpcConn)
+	var (
+		channels []*chantypes.IdentifiedChannel
+		next     []byte
+	)
+	for {
+		res, err := qc.ConnectionChannels(ctx, &chantypes.QueryConnectionChannelsRequest{
+			Connection: connectionID,
+			Pagination: &query.PageRequest{Key: next},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range res.Channels {
+			if ch.PortId == portID {
+				channels = append(channels, ch)
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return channels, nil
+		}
+		next = res.Pagination.NextKey
+	}
+}
+
+// ICAQueryActiveChannel returns the open interchain accounts channel of owner on the given connection.
+func (c *CosmosChain) ICAQueryActiveChannel(ctx context.Context, connectionID, owner string) (*chantypes.IdentifiedChannel, error) {
+	channels, err := c.ICAQueryChannels(ctx, connectionID, owner)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(channels) - 1; i >= 0; i-- {
+		if channels[i].State == chantypes.OPEN {
+			return channels[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no open interchain accounts channel for %s on %s", owner, connectionID)
+}
+
+// RecoverICA re-registers the interchain account of keyName on the given connection
+// after its channel was closed, e.g. by a packet timing out on an ordered channel.
+// The relayer completes the new channel handshake to the same interchain account;
+// wait for it with PollForICAChannelOpen.
+// An error is returned if the account still has an open channel.
+func (c *CosmosChain) RecoverICA(ctx context.Context, keyName, connectionID string, ordering ibc.Order) (string, error) {
+	owner, err := c.getFullNode().AccountKeyBech32(ctx, keyName)
+	if err != nil {
+		return "", err
+	}
+	if ch, err := c.ICAQueryActiveChannel(ctx, connectionID, owner); err == nil {
+		return "", fmt.Errorf("interchain account of %s still has open channel %s", owner, ch.ChannelId)
+	}
+	return c.getFullNode().RegisterICAWithOrdering(ctx, keyName, connectionID, ordering)
+}
+
+// ICAHostQueryParams returns the params of the interchain accounts host module, including its message allowlist.
+func (c *CosmosChain) ICAHostQueryParams(ctx context.Context) (*icahosttypes.Params, error) {
+	res, err := icahosttypes.NewQueryClient(c.GetNode().GrpcConn).Params(ctx, &icahosttypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Params, nil
+}
+
+// ICAControllerQueryParams returns the params of the interchain accounts controller module.
+func (c *CosmosChain) ICAControllerQueryParams(ctx context.Context) (*icacontrollertypes.Params, error) {
+	res, err := icacontrollertypes.NewQueryClient(c.GetNode().GrpcConn).Params(ctx, &icacontrollertypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Params, nil
+}
+
+// ICAHostUpdateParamsMsg returns the governance message replacing the params of the interchain accounts host module,
+// e.g. to change its message allowlist. Submit it in a proposal; see BuildProposal.
+func (c *CosmosChain) ICAHostUpdateParamsMsg(ctx context.Context, params icahosttypes.Params) (*icahosttypes.MsgUpdateParams, error) {
+	authority, err := c.AuthQueryModuleAddress(ctx, "gov")
+	if err != nil {
+		return nil, err
+	}
+	return icahosttypes.NewMsgUpdateParams(authority, params), nil
+}
+
+// ICAGovRegisterMsg returns the governance message registering an interchain account owned by the gov module
+// on the given connection. Submit it in a proposal; see BuildProposal.
+// Once registered, QueryICAAddress with the gov module address returns the account.
+func (c *CosmosChain) ICAGovRegisterMsg(ctx context.Context, connectionID, version string, ordering ibc.Order) (*icacontrollertypes.MsgRegisterInterchainAccount, error) {
+	authority, err := c.AuthQueryModuleAddress(ctx, "gov")
+	if err != nil {
+		return nil, err
+	}
+	return icacontrollertypes.NewMsgRegisterInterchainAccountWithOrdering(connectionID, authority, version, icaOrdering(ordering)), nil
+}
+
+// ICAGovSendTxMsg returns the governance message executing msgs on the interchain account of the gov module
+// on the given connection, timing out after relativeTimeout. Submit it in a proposal; see BuildProposal.
+// msgs are signed by the interchain account, so they must use its address on the host chain.
+func (c *CosmosChain) ICAGovSendTxMsg(ctx context.Context, connectionID string, msgs []sdk.Msg, memo string, relativeTimeout time.Duration) (*icacontrollertypes.MsgSendTx, error) {
+	authority, err := c.AuthQueryModuleAddress(ctx, "gov")
+	if err != nil {
+		return nil, err
+	}
+	packetData, err := NewICAPacketData(c.Config().EncodingConfig.InterfaceRegistry, msgs, memo)
+	if err != nil {
+		return nil, err
+	}
+	return icacontrollertypes.NewMsgSendTx(authority, connectionID, uint64(relativeTimeout.Nanoseconds()), packetData), nil
+}
+
+// icaOrdering converts o to the channel ordering of an interchain accounts channel,
+// which is ordered unless o is ibc.Unordered.
+func icaOrdering(o ibc.Order) chantypes.Order {
+	if o == ibc.Unordered {
+		return chantypes.UNORDERED
+	}
+	return chantypes.ORDERED
+}
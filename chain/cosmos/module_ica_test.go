@@ -0,0 +1,48 @@
+package cosmos_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewICAPacketData(t *testing.T) {
+	registry := cosmos.DefaultEncoding().InterfaceRegistry
+	send := &banktypes.MsgSend{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))}
+
+	packetData, err := cosmos.NewICAPacketData(registry, []sdk.Msg{send}, "memo")
+	require.NoError(t, err)
+	require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+	require.Equal(t, "memo", packetData.Memo)
+
+	msgs, err := icatypes.DeserializeCosmosTx(codec.NewProtoCodec(registry), packetData.Data, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, send.ToAddress, msgs[0].(*banktypes.MsgSend).ToAddress)
+}
+
+func TestICADecodeAck(t *testing.T) {
+	registry := cosmos.DefaultEncoding().InterfaceRegistry
+
+	res, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	require.NoError(t, err)
+	txMsgData := sdk.TxMsgData{MsgResponses: []*codectypes.Any{res}}
+	bz, err := txMsgData.Marshal()
+	require.NoError(t, err)
+
+	responses, err := cosmos.ICADecodeAck(registry, chantypes.NewResultAcknowledgement(bz).Acknowledgement())
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	require.IsType(t, &banktypes.MsgSendResponse{}, responses[0])
+
+	_, err = cosmos.ICADecodeAck(registry, chantypes.NewErrorAcknowledgement(errors.New("boom")).Acknowledgement())
+	require.ErrorContains(t, err, "error acknowledgement")
+}
@@ -0,0 +1,372 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Identifiers of the ibc-apps async-icq host module.
+const (
+	ICQHostPort = "icqhost"
+	ICQVersion  = "icq-1"
+)
+
+// Identifiers of the Neutron interchainqueries module.
+const (
+	ICQRegisterQueryMsgType = "/neutron.interchainqueries.MsgRegisterInterchainQuery"
+	ICQQueryTypeKV          = "kv"
+	ICQQueryTypeTX          = "tx"
+)
+
+// icqRegisterQueryGas is the gas limit of the transactions registering Neutron-style interchain queries.
+const icqRegisterQueryGas = 500_000
+
+// ICQChannelOpts returns the options for creating an async-icq channel
+// from controllerPort, e.g. "wasm.<contract address>", to the host chain's icqhost port.
+func ICQChannelOpts(controllerPort string) ibc.CreateChannelOptions {
+	return ibc.CreateChannelOptions{
+		SourcePortName: controllerPort,
+		DestPortName:   ICQHostPort,
+		Order:          ibc.Unordered,
+		Version:        ICQVersion,
+	}
+}
+
+// ICQHostGenesis returns the genesis values enabling the async-icq host module
+// to answer queries of the given gRPC methods, e.g. "/cosmos.bank.v1beta1.Query/AllBalances".
+// Pass the result to ModifyGenesis.
+func ICQHostGenesis(allowQueries ...string) []GenesisKV {
+	return []GenesisKV{
+		NewGenesisKV("app_state.interchainquery.host_port", ICQHostPort),
+		NewGenesisKV("app_state.interchainquery.params.host_enabled", true),
+		NewGenesisKV("app_state.interchainquery.params.allow_queries", allowQueries),
+	}
+}
+
+// NewICQRequest returns an interchain query of the gRPC method path,
+// e.g. "/cosmos.bank.v1beta1.Query/AllBalances", with the protobuf encoded req.
+func NewICQRequest(path string, req proto.Message) (abci.RequestQuery, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return abci.RequestQuery{}, fmt.Errorf("failed to marshal %s request: %w", path, err)
+	}
+	return abci.RequestQuery{Path: path, Data: data}, nil
+}
+
+// ICQPacketData returns the data of an async-icq packet requesting reqs,
+// as sent by controller modules and contracts.
+func ICQPacketData(memo string, reqs ...abci.RequestQuery) ([]byte, error) {
+	// CosmosQuery has a single repeated field of requests.
+	var query []byte
+	for _, req := range reqs {
+		bz, err := req.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request %s: %w", req.Path, err)
+		}
+		query = protowire.AppendTag(query, 1, protowire.BytesType)
+		query = protowire.AppendBytes(query, bz)
+	}
+	return json.Marshal(icqPacketData{Data: query, Memo: memo})
+}
+
+// ICQDecodeAck decodes the acknowledgement of an async-icq packet
+// into the host's responses, in the order of the requests.
+// An error acknowledgement, such as for a query the host does not allow, is returned as an error.
+func ICQDecodeAck(ack []byte) ([]abci.ResponseQuery, error) {
+	result, err := ackResult(ack)
+	if err != nil {
+		return nil, err
+	}
+
+	var icqAck icqPacketAck
+	if err := json.Unmarshal(result, &icqAck); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal async-icq acknowledgement: %w", err)
+	}
+
+	// CosmosResponse has a single repeated field of responses.
+	var (
+		responses []abci.ResponseQuery
+		bz        = icqAck.Data
+	)
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, fmt.Errorf("malformed async-icq response: %w", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return nil, fmt.Errorf("malformed async-icq response: %w", protowire.ParseError(n))
+			}
+			bz = bz[n:]
+			continue
+		}
+
+		v, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return nil, fmt.Errorf("malformed async-icq response: %w", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		var res abci.ResponseQuery
+		if err := res.Unmarshal(v); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal async-icq response: %w", err)
+		}
+		responses = append(responses, res)
+	}
+	return responses, nil
+}
+
+// ICQDecodeResponse unmarshals the value of a successful async-icq response into res,
+// the response type of the queried gRPC method.
+func ICQDecodeResponse(r abci.ResponseQuery, res proto.Message) error {
+	if r.Code != 0 {
+		return fmt.Errorf("query failed (codespace: %s, code: %d): %s", r.Codespace, r.Code, r.Log)
+	}
+	return proto.Unmarshal(r.Value, res)
+}
+
+// ICQSendQueryFromContract executes the query message of the ibc-apps async-icq sample controller contract
+// at contractAddr, sending reqs to the host chain over channelID, and returns the transaction carrying the query packet.
+// The acknowledgement can then be awaited with testutil.PollForAck and decoded with ICQDecodeAck.
+func (c *CosmosChain) ICQSendQueryFromContract(ctx context.Context, keyName, contractAddr, channelID string, timeoutSeconds uint64, reqs ...abci.RequestQuery) (ibc.Tx, error) {
+	requests := make([]icqContractRequest, len(reqs))
+	for i, req := range reqs {
+		requests[i] = icqContractRequest{Data: req.Data, Path: req.Path, Height: req.Height, Prove: req.Prove}
+	}
+	msg, err := json.Marshal(map[string]any{
+		"query": map[string]any{
+			"channel":  channelID,
+			"requests": requests,
+			"timeout":  timeoutSeconds,
+		},
+	})
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	txResp, err := c.ExecuteContract(ctx, keyName, contractAddr, string(msg))
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+	return packetTx(txResp)
+}
+
+// NewICQBalanceKVKey returns the key of the bank balance of addr in denom,
+// for registering a Neutron-style KV interchain query.
+func NewICQBalanceKVKey(addr sdk.AccAddress, denom string) ICQKVKey {
+	key := append(banktypes.BalancesPrefix.Bytes(), address.MustLengthPrefix(addr)...)
+	return ICQKVKey{
+		Path: banktypes.StoreKey,
+		Key:  append(key, denom...),
+	}
+}
+
+// NewICQTransferTxFilter returns the transactions filter of a Neutron-style TX interchain query
+// matching transfers received by recipient from the given height.
+func NewICQTransferTxFilter(recipient string, minHeight uint64) []ICQTransactionFilter {
+	return []ICQTransactionFilter{
+		{Field: "transfer.recipient", Op: "Eq", Value: recipient},
+		{Field: "tx.height", Op: "Gte", Value: minHeight},
+	}
+}
+
+// NewICQRegisterKVQueryMsg returns the message registering a Neutron-style KV interchain query of keys
+// on the counterparty chain of connectionID, updated every updatePeriod blocks.
+func NewICQRegisterKVQueryMsg(sender, connectionID string, updatePeriod uint64, keys ...ICQKVKey) ICQRegisterQueryMsg {
+	return ICQRegisterQueryMsg{
+		Type:         ICQRegisterQueryMsgType,
+		QueryType:    ICQQueryTypeKV,
+		Keys:         keys,
+		ConnectionID: connectionID,
+		UpdatePeriod: updatePeriod,
+		Sender:       sender,
+	}
+}
+
+// NewICQRegisterTxQueryMsg returns the message registering a Neutron-style TX interchain query of the transactions
+// matching filter on the counterparty chain of connectionID, searched every updatePeriod blocks.
+func NewICQRegisterTxQueryMsg(sender, connectionID string, updatePeriod uint64, filter []ICQTransactionFilter) (ICQRegisterQueryMsg, error) {
+	bz, err := json.Marshal(filter)
+	if err != nil {
+		return ICQRegisterQueryMsg{}, fmt.Errorf("failed to marshal transactions filter: %w", err)
+	}
+	return ICQRegisterQueryMsg{
+		Type:               ICQRegisterQueryMsgType,
+		QueryType:          ICQQueryTypeTX,
+		Keys:               []ICQKVKey{},
+		TransactionsFilter: string(bz),
+		ConnectionID:       connectionID,
+		UpdatePeriod:       updatePeriod,
+		Sender:             sender,
+	}, nil
+}
+
+// NewICQRegisterQueryTx returns the unsigned transaction of msg paying fee, to be signed with the chain binary.
+func NewICQRegisterQueryTx(msg ICQRegisterQueryMsg, fee sdk.Coins) ([]byte, error) {
+	if fee == nil {
+		fee = sdk.Coins{}
+	}
+	return json.Marshal(map[string]any{
+		"body": map[string]any{
+			"messages": []ICQRegisterQueryMsg{msg},
+		},
+		"auth_info": map[string]any{
+			"fee": map[string]any{
+				"amount":    fee,
+				"gas_limit": strconv.Itoa(icqRegisterQueryGas),
+			},
+		},
+		"signatures": []string{},
+	})
+}
+
+// ICQRegisterKVQuery registers a Neutron-style KV interchain query of keys on the counterparty chain of connectionID,
+// updated every updatePeriod blocks, and returns the registered query. The query deposit is paid by keyName.
+func (c *CosmosChain) ICQRegisterKVQuery(ctx context.Context, keyName, connectionID string, updatePeriod uint64, keys ...ICQKVKey) (*ICQRegisteredQuery, error) {
+	sender, err := c.getFullNode().KeyBech32(ctx, keyName, "")
+	if err != nil {
+		return nil, err
+	}
+	return c.icqRegisterQuery(ctx, keyName, NewICQRegisterKVQueryMsg(sender, connectionID, updatePeriod, keys...))
+}
+
+// ICQRegisterTxQuery registers a Neutron-style TX interchain query of the transactions matching filter
+// on the counterparty chain of connectionID, searched every updatePeriod blocks, and returns the registered query.
+// The query deposit is paid by keyName.
+func (c *CosmosChain) ICQRegisterTxQuery(ctx context.Context, keyName, connectionID string, updatePeriod uint64, filter []ICQTransactionFilter) (*ICQRegisteredQuery, error) {
+	sender, err := c.getFullNode().KeyBech32(ctx, keyName, "")
+	if err != nil {
+		return nil, err
+	}
+	msg, err := NewICQRegisterTxQueryMsg(sender, connectionID, updatePeriod, filter)
+	if err != nil {
+		return nil, err
+	}
+	return c.icqRegisterQuery(ctx, keyName, msg)
+}
+
+// icqRegisterQuery signs and broadcasts a transaction of msg, as the CLI of the interchainqueries module
+// has no command registering queries, and returns the query registered last by the sender.
+func (c *CosmosChain) icqRegisterQuery(ctx context.Context, keyName string, msg ICQRegisterQueryMsg) (*ICQRegisteredQuery, error) {
+	tn := c.getFullNode()
+
+	fee, err := icqRegisterQueryFee(c.Config().GasPrices)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := NewICQRegisterQueryTx(msg, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	unsigned := fmt.Sprintf("icq_register_%s.json", keyName)
+	if err := tn.WriteFile(ctx, tx, unsigned); err != nil {
+		return nil, fmt.Errorf("failed to write interchain query registration: %w", err)
+	}
+	signedTx, stderr, err := tn.Exec(ctx, tn.TxCommand(keyName, "sign", path.Join(tn.HomeDir(), unsigned)), c.Config().Env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign interchain query registration: %w\nstderr: %s", err, stderr)
+	}
+	signed := fmt.Sprintf("icq_register_%s_signed.json", keyName)
+	if err := tn.WriteFile(ctx, signedTx, signed); err != nil {
+		return nil, fmt.Errorf("failed to write signed interchain query registration: %w", err)
+	}
+	if _, err := tn.ExecTx(ctx, keyName, "broadcast", path.Join(tn.HomeDir(), signed)); err != nil {
+		return nil, fmt.Errorf("failed to register interchain query: %w", err)
+	}
+
+	queries, err := c.ICQQueryRegisteredQueries(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	var last *ICQRegisteredQuery
+	for i := range queries {
+		if last == nil || queries[i].ID > last.ID {
+			last = &queries[i]
+		}
+	}
+	if last == nil {
+		return nil, fmt.Errorf("no interchain query registered by %s", msg.Sender)
+	}
+	return last, nil
+}
+
+// icqRegisterQueryFee returns the fee of a registration transaction at the gas prices of the chain config.
+func icqRegisterQueryFee(gasPrices string) (sdk.Coins, error) {
+	prices, err := sdk.ParseDecCoins(gasPrices)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas prices %q: %w", gasPrices, err)
+	}
+	fee := sdk.NewCoins()
+	for _, p := range prices {
+		fee = fee.Add(sdk.NewCoin(p.Denom, p.Amount.MulInt64(icqRegisterQueryGas).Ceil().TruncateInt()))
+	}
+	return fee, nil
+}
+
+// ICQQueryRegisteredQueries returns the Neutron-style interchain queries registered by owner,
+// or by every owner if owner is empty.
+func (c *CosmosChain) ICQQueryRegisteredQueries(ctx context.Context, owner string) ([]ICQRegisteredQuery, error) {
+	cmd := []string{"interchainqueries", "registered-queries"}
+	if owner != "" {
+		cmd = append(cmd, "--owners", owner)
+	}
+	stdout, stderr, err := c.getFullNode().ExecQuery(ctx, cmd...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query registered interchain queries: %w\nstdout: %s\nstderr: %s", err, stdout, stderr)
+	}
+
+	var res struct {
+		RegisteredQueries []ICQRegisteredQuery `json:"registered_queries"`
+	}
+	if err := json.Unmarshal(stdout, &res); err != nil {
+		return nil, err
+	}
+	return res.RegisteredQueries, nil
+}
+
+// ICQQueryRegisteredQuery returns the Neutron-style interchain query with the given ID.
+func (c *CosmosChain) ICQQueryRegisteredQuery(ctx context.Context, queryID uint64) (*ICQRegisteredQuery, error) {
+	stdout, stderr, err := c.getFullNode().ExecQuery(ctx, "interchainqueries", "registered-query", strconv.FormatUint(queryID, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query registered interchain query %d: %w\nstdout: %s\nstderr: %s", queryID, err, stdout, stderr)
+	}
+
+	var res struct {
+		RegisteredQuery ICQRegisteredQuery `json:"registered_query"`
+	}
+	if err := json.Unmarshal(stdout, &res); err != nil {
+		return nil, err
+	}
+	return &res.RegisteredQuery, nil
+}
+
+// ICQQueryResult returns the last result submitted for the Neutron-style KV interchain query with the given ID.
+func (c *CosmosChain) ICQQueryResult(ctx context.Context, queryID uint64) (*ICQQueryResult, error) {
+	stdout, stderr, err := c.getFullNode().ExecQuery(ctx, "interchainqueries", "query-result", strconv.FormatUint(queryID, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query interchain query result %d: %w\nstdout: %s\nstderr: %s", queryID, err, stdout, stderr)
+	}
+
+	var res struct {
+		Result ICQQueryResult `json:"result"`
+	}
+	if err := json.Unmarshal(stdout, &res); err != nil {
+		return nil, err
+	}
+	return &res.Result, nil
+}
@@ -0,0 +1,117 @@
+package cosmos_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestICQPacketData(t *testing.T) {
+	req, err := cosmos.NewICQRequest("/cosmos.bank.v1beta1.Query/AllBalances", &banktypes.QueryAllBalancesRequest{Address: "cosmos1abc"})
+	require.NoError(t, err)
+
+	data, err := cosmos.ICQPacketData("", req)
+	require.NoError(t, err)
+
+	var packet struct {
+		Data []byte `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(data, &packet))
+
+	num, typ, n := protowire.ConsumeTag(packet.Data)
+	require.Equal(t, protowire.Number(1), num)
+	require.Equal(t, protowire.BytesType, typ)
+	bz, _ := protowire.ConsumeBytes(packet.Data[n:])
+
+	var decoded abci.RequestQuery
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, req.Path, decoded.Path)
+
+	var bankReq banktypes.QueryAllBalancesRequest
+	require.NoError(t, bankReq.Unmarshal(decoded.Data))
+	require.Equal(t, "cosmos1abc", bankReq.Address)
+}
+
+func TestICQDecodeAck(t *testing.T) {
+	balances := &banktypes.QueryAllBalancesResponse{Balances: sdk.NewCoins(sdk.NewInt64Coin("uatom", 42))}
+	value, err := balances.Marshal()
+	require.NoError(t, err)
+
+	var responses []byte
+	for _, res := range []abci.ResponseQuery{{Value: value}, {Code: 6, Codespace: "sdk", Log: "unknown query path"}} {
+		bz, err := res.Marshal()
+		require.NoError(t, err)
+		responses = protowire.AppendTag(responses, 1, protowire.BytesType)
+		responses = protowire.AppendBytes(responses, bz)
+	}
+	result, err := json.Marshal(map[string][]byte{"data": responses})
+	require.NoError(t, err)
+	ack := chantypes.NewResultAcknowledgement(result).Acknowledgement()
+
+	decoded, err := cosmos.ICQDecodeAck(ack)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+
+	var got banktypes.QueryAllBalancesResponse
+	require.NoError(t, cosmos.ICQDecodeResponse(decoded[0], &got))
+	require.Equal(t, balances.Balances, got.Balances)
+	require.ErrorContains(t, cosmos.ICQDecodeResponse(decoded[1], &got), "unknown query path")
+
+	_, err = cosmos.ICQDecodeAck(chantypes.NewErrorAcknowledgement(errors.New("boom")).Acknowledgement())
+	require.ErrorContains(t, err, "error acknowledgement")
+}
+
+func TestICQRegisterQueryMsg(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	key := cosmos.NewICQBalanceKVKey(addr, "uatom")
+	require.Equal(t, banktypes.StoreKey, key.Path)
+
+	kv := cosmos.NewICQRegisterKVQueryMsg("neutron1sender", "connection-0", 5, key)
+	bz, err := json.Marshal(kv)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"@type": "/neutron.interchainqueries.MsgRegisterInterchainQuery",
+		"query_type": "kv",
+		"keys": [{"path": "bank", "key": "`+base64.StdEncoding.EncodeToString(key.Key)+`"}],
+		"transactions_filter": "",
+		"connection_id": "connection-0",
+		"update_period": "5",
+		"sender": "neutron1sender"
+	}`, string(bz))
+
+	tx, err := cosmos.NewICQRegisterTxQueryMsg("neutron1sender", "connection-0", 10, cosmos.NewICQTransferTxFilter("cosmos1abc", 100))
+	require.NoError(t, err)
+	require.Equal(t, cosmos.ICQQueryTypeTX, tx.QueryType)
+	require.Empty(t, tx.Keys)
+	require.JSONEq(t, `[
+		{"field": "transfer.recipient", "op": "Eq", "value": "cosmos1abc"},
+		{"field": "tx.height", "op": "Gte", "value": 100}
+	]`, tx.TransactionsFilter)
+
+	unsigned, err := cosmos.NewICQRegisterQueryTx(tx, sdk.NewCoins(sdk.NewInt64Coin("untrn", 1250)))
+	require.NoError(t, err)
+	var decoded struct {
+		Body struct {
+			Messages []cosmos.ICQRegisterQueryMsg `json:"messages"`
+		} `json:"body"`
+		AuthInfo struct {
+			Fee struct {
+				Amount   sdk.Coins `json:"amount"`
+				GasLimit string    `json:"gas_limit"`
+			} `json:"fee"`
+		} `json:"auth_info"`
+	}
+	require.NoError(t, json.Unmarshal(unsigned, &decoded))
+	require.Equal(t, []cosmos.ICQRegisterQueryMsg{tx}, decoded.Body.Messages)
+	require.Equal(t, "1250untrn", decoded.AuthInfo.Fee.Amount.String())
+	require.Equal(t, "500000", decoded.AuthInfo.Fee.GasLimit)
+}
@@ -0,0 +1,64 @@
+package cosmos
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// TransferQueryDenomTraces returns every denom trace known to the chain's transfer module,
+// i.e. the origin of every IBC voucher the chain has received.
+func (c *CosmosChain) TransferQueryDenomTraces(ctx context.Context) ([]transfertypes.DenomTrace, error) {
+	qc := transfertypes.NewQueryClient(c.GetNode().GrpcConn)
+
+	var (
+		traces []transfertypes.DenomTrace
+		next   []byte
+	)
+	for {
+		res, err := qc.DenomTraces(ctx, &transfertypes.QueryDenomTracesRequest{
+			Pagination: &query.PageRequest{Key: next},
+		})
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, res.DenomTraces...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return traces, nil
+		}
+		next = res.Pagination.NextKey
+	}
+}
+
+// TransferQueryDenomTrace returns the denom trace of an IBC voucher,
+// given either its hash or its full denom, e.g. "ibc/27394FB0...".
+func (c *CosmosChain) TransferQueryDenomTrace(ctx context.Context, hash string) (*transfertypes.DenomTrace, error) {
+	res, err := transfertypes.NewQueryClient(c.GetNode().GrpcConn).DenomTrace(ctx, &transfertypes.QueryDenomTraceRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return res.DenomTrace, nil
+}
+
+// TransferQueryEscrowAddress returns the address escrowing tokens sent from the chain over the given channel.
+func (c *CosmosChain) TransferQueryEscrowAddress(ctx context.Context, portID, channelID string) (string, error) {
+	res, err := transfertypes.NewQueryClient(c.GetNode().GrpcConn).EscrowAddress(ctx, &transfertypes.QueryEscrowAddressRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.EscrowAddress, nil
+}
+
+// TransferQueryTotalEscrowForDenom returns the amount of denom escrowed across all of the chain's channels.
+func (c *CosmosChain) TransferQueryTotalEscrowForDenom(ctx context.Context, denom string) (sdkmath.Int, error) {
+	res, err := transfertypes.NewQueryClient(c.GetNode().GrpcConn).TotalEscrowForDenom(ctx, &transfertypes.QueryTotalEscrowForDenomRequest{Denom: denom})
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return res.Amount.Amount, nil
+}
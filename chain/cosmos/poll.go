@@ -8,6 +8,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
@@ -49,6 +51,34 @@ func PollForProposalStatus(ctx context.Context, chain *CosmosChain, startHeight,
 	return bp.DoPoll(ctx, startHeight, maxHeight)
 }
 
+// PollForICAChannelOpen polls until the interchain account of owner on the given connection has an open channel,
+// such as after RegisterICA or RecoverICA.
+func PollForICAChannelOpen(ctx context.Context, chain *CosmosChain, startHeight, maxHeight int64, connectionID, owner string) (*chantypes.IdentifiedChannel, error) {
+	doPoll := func(ctx context.Context, height int64) (*chantypes.IdentifiedChannel, error) {
+		return chain.ICAQueryActiveChannel(ctx, connectionID, owner)
+	}
+	bp := testutil.BlockPoller[*chantypes.IdentifiedChannel]{CurrentHeight: chain.Height, PollFunc: doPoll}
+	return bp.DoPoll(ctx, startHeight, maxHeight)
+}
+
+// PollForClientStatus polls until the light client with the given ID has the given status,
+// e.g. ibcexported.Frozen after IBCSubmitMisbehaviour or ibcexported.Active after a client recovery.
+func PollForClientStatus(ctx context.Context, chain *CosmosChain, startHeight, maxHeight int64, clientID string, status ibcexported.Status) error {
+	doPoll := func(ctx context.Context, height int64) (ibcexported.Status, error) {
+		s, err := chain.IBCQueryClientStatus(ctx, clientID)
+		if err != nil {
+			return "", err
+		}
+		if s != status {
+			return "", fmt.Errorf("client %s status (%s) does not match expected: (%s)", clientID, s, status)
+		}
+		return s, nil
+	}
+	bp := testutil.BlockPoller[ibcexported.Status]{CurrentHeight: chain.Height, PollFunc: doPoll}
+	_, err := bp.DoPoll(ctx, startHeight, maxHeight)
+	return err
+}
+
 // PollForMessage searches every transaction for a message. Must pass a coded registry capable of decoding the cosmos transaction.
 // fn is optional. Return true from the fn to stop polling and return the found message. If fn is nil, returns the first message to match type T.
 func PollForMessage[T any](ctx context.Context, chain *CosmosChain, registry codectypes.InterfaceRegistry, startHeight, maxHeight int64, fn func(found T) bool) (T, error) {
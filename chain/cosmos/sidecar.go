@@ -107,6 +107,9 @@ func (s *SidecarProcess) logger() *zap.Logger {
 }
 
 func (s *SidecarProcess) CreateContainer(ctx context.Context) error {
+	if c, ok := s.Chain.(*CosmosChain); ok {
+		s.containerLifecycle.SetEventReporter(c.containerEventReporter())
+	}
 	return s.containerLifecycle.CreateContainer(ctx, s.TestName, s.NetworkID, s.Image, s.ports, s.Bind(), nil, s.HostName(), s.startCmd, s.env)
 }
 
@@ -221,3 +221,73 @@ type ContractInfoResponse struct {
 		Extension any    `json:"extension"`
 	} `json:"contract_info"`
 }
+
+// icqPacketData is the JSON encoded data of an async-icq packet, whose Data is a protobuf encoded CosmosQuery.
+type icqPacketData struct {
+	Data []byte `json:"data"`
+	Memo string `json:"memo,omitempty"`
+}
+
+// icqPacketAck is the JSON encoded result of an async-icq acknowledgement, whose Data is a protobuf encoded CosmosResponse.
+type icqPacketAck struct {
+	Data []byte `json:"data"`
+}
+
+// icqContractRequest is a query request as accepted by CosmWasm contracts,
+// which reject requests omitting the height and prove fields.
+type icqContractRequest struct {
+	Data   []byte `json:"data"`
+	Path   string `json:"path"`
+	Height int64  `json:"height"`
+	Prove  bool   `json:"prove"`
+}
+
+// ICQKVKey is a key of the counterparty chain's store read by a Neutron-style KV interchain query.
+type ICQKVKey struct {
+	// Path is the name of the store, e.g. "bank".
+	Path string `json:"path"`
+	Key  []byte `json:"key"`
+}
+
+// ICQTransactionFilter is a condition on the events of transactions matched by a Neutron-style TX interchain query.
+// A list of filters is registered as its JSON encoding.
+type ICQTransactionFilter struct {
+	Field string `json:"field"`
+	Op    string `json:"op"`
+	Value any    `json:"value"`
+}
+
+// ICQRegisterQueryMsg is a Neutron MsgRegisterInterchainQuery, registering a KV or TX interchain query.
+type ICQRegisterQueryMsg struct {
+	Type               string     `json:"@type"`
+	QueryType          string     `json:"query_type"`
+	Keys               []ICQKVKey `json:"keys"`
+	TransactionsFilter string     `json:"transactions_filter"`
+	ConnectionID       string     `json:"connection_id"`
+	UpdatePeriod       uint64     `json:"update_period,string"`
+	Sender             string     `json:"sender"`
+}
+
+// ICQRegisteredQuery is a Neutron-style interchain query registered on the chain.
+type ICQRegisteredQuery struct {
+	ID                             uint64     `json:"id,string"`
+	Owner                          string     `json:"owner"`
+	QueryType                      string     `json:"query_type"`
+	Keys                           []ICQKVKey `json:"keys"`
+	TransactionsFilter             string     `json:"transactions_filter"`
+	ConnectionID                   string     `json:"connection_id"`
+	UpdatePeriod                   uint64     `json:"update_period,string"`
+	LastSubmittedResultLocalHeight uint64     `json:"last_submitted_result_local_height,string"`
+	RegisteredAtHeight             uint64     `json:"registered_at_height,string"`
+}
+
+// ICQQueryResult is the last result submitted for a Neutron-style KV interchain query.
+type ICQQueryResult struct {
+	KVResults []struct {
+		StoragePrefix string `json:"storage_prefix"`
+		Key           []byte `json:"key"`
+		Value         []byte `json:"value"`
+	} `json:"kv_results"`
+	Height   uint64 `json:"height,string"`
+	Revision uint64 `json:"revision,string"`
+}
@@ -143,6 +143,22 @@ func (c *EthereumChain) KeystoreDir() string {
 	return path.Join(c.HomeDir(), ".foundry", "keystores")
 }
 
+// ContainerID returns the id of the anvil container, empty until the chain started.
+func (c *EthereumChain) ContainerID() string {
+	if c.containerLifecycle == nil {
+		return ""
+	}
+	return c.containerLifecycle.ContainerID()
+}
+
+// WriteFile accepts file contents in a byte slice and writes the contents to
+// the docker filesystem. relPath describes the location of the file in the
+// docker volume relative to the home directory
+func (c *EthereumChain) WriteFile(ctx context.Context, content []byte, relPath string) error {
+	fw := dockerutil.NewFileWriter(c.logger(), c.DockerClient, c.testName)
+	return fw.WriteFile(ctx, c.VolumeName, relPath, content)
+}
+
 func (c *EthereumChain) Bind() []string {
 	return []string{fmt.Sprintf("%s:%s", c.VolumeName, c.HomeDir())}
 }
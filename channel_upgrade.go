@@ -0,0 +1,55 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// UpgradeChannel completes the upgrade of the channel bound to portID on initiator,
+// once MsgChannelUpgradeInit has been executed on initiator, e.g. through a governance proposal.
+// Each relayer step of the handshake (try, ack, confirm and open) is submitted in turn through l.Relayer,
+// so the relayer must have the relayer.ChannelUpgrade capability and should not be relaying the path concurrently.
+//
+// On success, the upgraded channel in l.Channels is refreshed with its new version and ordering.
+func (l *Link) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, initiator ibc.Chain, portID string) error {
+	counterparty, ok := l.Counterparty(initiator)
+	if !ok {
+		return fmt.Errorf("chain %s is not part of path %s", initiator.Config().ChainID, l.Path)
+	}
+	initiatorCh, ok := l.ChannelOn(initiator, portID)
+	if !ok {
+		return fmt.Errorf("no channel on port %s of chain %s in path %s", portID, initiator.Config().ChainID, l.Path)
+	}
+	counterpartyCh := flipChannel(initiatorCh, counterparty.ConnectionID)
+
+	for _, step := range ibc.ChannelUpgradeSteps {
+		opts := ibc.ChannelUpgradeOptions{ChainID: counterparty.Chain.Config().ChainID, Channel: counterpartyCh}
+		if step.OnInitiator() {
+			opts = ibc.ChannelUpgradeOptions{ChainID: initiator.Config().ChainID, Channel: initiatorCh}
+		}
+		if err := l.Relayer.UpgradeChannel(ctx, rep, l.Path, step, opts); err != nil {
+			return fmt.Errorf("failed to submit channel upgrade %s on %s: %w", step, opts.ChainID, err)
+		}
+	}
+
+	return l.refreshChannels(ctx, rep)
+}
+
+// refreshChannels replaces the channels of l with their current state as seen from Chain1.
+func (l *Link) refreshChannels(ctx context.Context, rep ibc.RelayerExecReporter) error {
+	channels, err := l.Relayer.GetChannels(ctx, rep, l.Chain1.Chain.Config().ChainID)
+	if err != nil {
+		return fmt.Errorf("failed to query channels of path %s: %w", l.Path, err)
+	}
+	for i, ch := range l.Channels {
+		for _, current := range channels {
+			if current.PortID == ch.PortID && current.ChannelID == ch.ChannelID {
+				l.Channels[i] = current
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package interchaintest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradeRelayer is an ibc.Relayer recording the channel upgrade steps it submits.
+type upgradeRelayer struct {
+	ibc.Relayer // Unused methods panic.
+
+	steps    []ibc.ChannelUpgradeStep
+	opts     []ibc.ChannelUpgradeOptions
+	channels []ibc.ChannelOutput
+}
+
+func (r *upgradeRelayer) UpgradeChannel(_ context.Context, _ ibc.RelayerExecReporter, _ string, step ibc.ChannelUpgradeStep, opts ibc.ChannelUpgradeOptions) error {
+	r.steps = append(r.steps, step)
+	r.opts = append(r.opts, opts)
+	return nil
+}
+
+func (r *upgradeRelayer) GetChannels(context.Context, ibc.RelayerExecReporter, string) ([]ibc.ChannelOutput, error) {
+	return r.channels, nil
+}
+
+func TestLink_UpgradeChannel(t *testing.T) {
+	gaia := &supplyChain{chainID: "gaia-1"}
+	osmosis := &supplyChain{chainID: "osmosis-1"}
+
+	ch := ibc.ChannelOutput{
+		PortID: "transfer", ChannelID: "channel-0", Version: "ics20-1", ConnectionHops: []string{"connection-0"},
+		Counterparty: ibc.ChannelCounterparty{PortID: "transfer", ChannelID: "channel-3"},
+	}
+	upgraded := ch
+	upgraded.Version = ibc.FeeEnabledVersion("ics20-1")
+
+	r := &upgradeRelayer{channels: []ibc.ChannelOutput{upgraded}}
+	link := &Link{
+		Relayer:  r,
+		Path:     "p",
+		Chain1:   LinkEnd{Chain: gaia, ConnectionID: "connection-0"},
+		Chain2:   LinkEnd{Chain: osmosis, ConnectionID: "connection-1"},
+		Channels: []ibc.ChannelOutput{ch},
+	}
+
+	// The upgrade was initialized on osmosis, so try and confirm go to gaia.
+	require.NoError(t, link.UpgradeChannel(context.Background(), nil, osmosis, "transfer"))
+	require.Equal(t, ibc.ChannelUpgradeSteps, r.steps)
+
+	require.Equal(t, "gaia-1", r.opts[0].ChainID)
+	require.Equal(t, ch, r.opts[0].Channel)
+	require.Equal(t, "osmosis-1", r.opts[1].ChainID)
+	require.Equal(t, "channel-3", r.opts[1].Channel.ChannelID)
+	require.Equal(t, []string{"connection-1"}, r.opts[1].Channel.ConnectionHops)
+	require.Equal(t, "gaia-1", r.opts[2].ChainID)
+	require.Equal(t, "osmosis-1", r.opts[3].ChainID)
+
+	require.Equal(t, upgraded, link.Channels[0])
+
+	require.ErrorContains(t, link.UpgradeChannel(context.Background(), nil, osmosis, "wasm.abc"), "no channel on port wasm.abc")
+}
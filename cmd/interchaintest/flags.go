@@ -19,6 +19,11 @@ type mainFlags struct {
 	MatrixFile        string
 	ReportFile        string
 	BlockDatabaseFile string
+
+	Benchmark         bool
+	BenchmarkChannels int
+	BenchmarkPackets  int
+	BenchmarkFile     string
 }
 
 func (f mainFlags) Logger() (lc LoggerCloser, _ error) {
@@ -192,6 +192,9 @@ func TestConformance(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping conformance tests in short mode")
 	}
+	if extraFlags.Benchmark {
+		t.Skip("skipping conformance tests in benchmark mode")
+	}
 
 	t.Parallel()
 
@@ -233,6 +236,64 @@ func TestConformance(t *testing.T) {
 	conformance.Test(t, ctx, chainFactories, relayerFactories, reporter)
 }
 
+// TestBenchmark is the root test for the relayer benchmarks, run instead of the conformance tests with -benchmark.
+// Relayers are benchmarked one after the other on each chain set, so that their reports are comparable.
+func TestBenchmark(t *testing.T) {
+	if !extraFlags.Benchmark {
+		t.Skip("skipping relayer benchmarks without -benchmark")
+	}
+
+	ctx := context.Background()
+
+	logger, err := extraFlags.Logger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = logger.Close() })
+	t.Logf("View chain and relayer logs at %s", logger.FilePath)
+
+	log := logger.Logger
+
+	relayerFactories := make([]interchaintest.RelayerFactory, len(testMatrix.Relayers))
+	for i, r := range testMatrix.Relayers {
+		rf, err := getRelayerFactory(r, log)
+		if err != nil {
+			// This error should have been validated before running tests.
+			panic(err)
+		}
+		relayerFactories[i] = rf
+	}
+
+	cfg := conformance.BenchmarkConfig{
+		Channels: extraFlags.BenchmarkChannels,
+		Packets:  extraFlags.BenchmarkPackets,
+	}
+
+	var reports []conformance.BenchmarkReport
+	for _, cs := range testMatrix.ChainSets {
+		cf, err := getChainFactory(log, cs)
+		if err != nil {
+			// This error should have been validated before running tests.
+			panic(err)
+		}
+		t.Run(cf.Name(), func(t *testing.T) {
+			reports = append(reports, conformance.Benchmark(t, ctx, cf, relayerFactories, reporter, cfg)...)
+		})
+	}
+
+	if extraFlags.BenchmarkFile == "" {
+		return
+	}
+	j, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(extraFlags.BenchmarkFile, j, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("Wrote benchmark reports to %s", extraFlags.BenchmarkFile)
+}
+
 // addFlags configures additional flags beyond the default testing flags.
 // Although pflag would have been slightly more developer friendly,
 // I ran out of time to spend on getting pflag to cooperate with the
@@ -245,6 +306,12 @@ func addFlags() {
 	flag.StringVar(&extraFlags.LogLevel, "log-level", "info", "Chain and relayer log level: debug|info|error")
 	flag.StringVar(&extraFlags.ReportFile, "report-file", "", "Path where test report will be stored. Defaults to $HOME/.interchaintest/reports/$TIMESTAMP.json")
 
+	benchmarkDefaults := conformance.DefaultBenchmarkConfig()
+	flag.BoolVar(&extraFlags.Benchmark, "benchmark", false, "Benchmark the relayers of the matrix instead of running the conformance tests")
+	flag.IntVar(&extraFlags.BenchmarkChannels, "benchmark-channels", benchmarkDefaults.Channels, "Number of transfer channels to open between the chains in benchmark mode")
+	flag.IntVar(&extraFlags.BenchmarkPackets, "benchmark-packets", benchmarkDefaults.Packets, "Number of packets to send in each direction on each channel in benchmark mode")
+	flag.StringVar(&extraFlags.BenchmarkFile, "benchmark-file", "", "Path where the JSON benchmark reports will be stored, if set")
+
 	debugFlagSet.StringVar(&extraFlags.BlockDatabaseFile, "block-db", interchaintest.DefaultBlockDatabaseFilepath(), "Path to database sqlite file that tracks blocks and transactions.")
 }
 
@@ -0,0 +1,440 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// BenchmarkConfig configures the load of a relayer benchmark.
+type BenchmarkConfig struct {
+	// Number of transfer channels opened between the two chains.
+	Channels int
+
+	// Number of packets sent in each direction on each channel,
+	// once while the relayer is running and once more as a backlog before it starts.
+	Packets int
+}
+
+// DefaultBenchmarkConfig returns the load used when none is specified.
+func DefaultBenchmarkConfig() BenchmarkConfig {
+	return BenchmarkConfig{
+		Channels: 2,
+		Packets:  10,
+	}
+}
+
+// Validate checks that the benchmark opens at least one channel and sends at least one packet.
+func (cfg BenchmarkConfig) Validate() error {
+	switch {
+	case cfg.Channels < 1:
+		return fmt.Errorf("benchmark needs at least 1 channel, got %d", cfg.Channels)
+	case cfg.Packets < 1:
+		return fmt.Errorf("benchmark needs at least 1 packet, got %d", cfg.Packets)
+	}
+	return nil
+}
+
+// BenchmarkReport holds the measurements of a relayer benchmark,
+// comparable across relayer factories given the same chain factory and config.
+type BenchmarkReport struct {
+	Relayer string          `json:"relayer"`
+	Chains  []string        `json:"chains"`
+	Config  BenchmarkConfig `json:"config"`
+
+	// Time from the block sending each packet to the block acknowledging it on the source chain,
+	// while the relayer is running.
+	Latencies []time.Duration `json:"latencies"`
+
+	// Time from the latest block once the relayer has started to the block acknowledging the last packet of the backlog.
+	BacklogClearTime time.Duration `json:"backlog_clear_time"`
+
+	// Transactions of the relayer wallet on each chain, keyed by chain ID.
+	RelayerTxs map[string]RelayerTxStats `json:"relayer_txs"`
+}
+
+// RelayerTxStats summarizes the transactions a relayer wallet sent to a chain.
+type RelayerTxStats struct {
+	Txs     int   `json:"txs"`
+	Msgs    int   `json:"msgs"`
+	GasUsed int64 `json:"gas_used"`
+}
+
+// MsgsPerTx returns the average number of messages per transaction, a measure of the relayer's batching.
+func (s RelayerTxStats) MsgsPerTx() float64 {
+	if s.Txs == 0 {
+		return 0
+	}
+	return float64(s.Msgs) / float64(s.Txs)
+}
+
+// LatencyPercentile returns the latency below which the given percentage of packets were relayed,
+// e.g. 50 for the median.
+func (r BenchmarkReport) LatencyPercentile(p float64) time.Duration {
+	if len(r.Latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), r.Latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	i := int(p/100*float64(len(sorted))+0.5) - 1
+	switch {
+	case i < 0:
+		i = 0
+	case i >= len(sorted):
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// WriteBenchmarkReports writes reports to w as a table, one row per relayer and chain.
+func WriteBenchmarkReports(w io.Writer, reports []BenchmarkReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "RELAYER\tCHAIN\tPACKETS\tP50\tP95\tMAX\tBACKLOG\tTXS\tMSGS/TX\tGAS")
+	for _, r := range reports {
+		packets := 2 * r.Config.Channels * r.Config.Packets
+		for _, chainID := range r.Chains {
+			s := r.RelayerTxs[chainID]
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\t%s\t%d\t%.2f\t%d\n",
+				r.Relayer, chainID, packets,
+				r.LatencyPercentile(50), r.LatencyPercentile(95), r.LatencyPercentile(100),
+				r.BacklogClearTime, s.Txs, s.MsgsPerTx(), s.GasUsed,
+			)
+		}
+	}
+	return tw.Flush()
+}
+
+// Benchmark runs BenchmarkRelayer for each relayer factory on chains of cf, one after the other,
+// and logs the resulting reports as a table.
+func Benchmark(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rfs []interchaintest.RelayerFactory, rep *testreporter.Reporter, cfg BenchmarkConfig) []BenchmarkReport {
+	rep.TrackTest(t)
+
+	var reports []BenchmarkReport
+	for _, rf := range rfs {
+		rf := rf
+		t.Run(rf.Name(), func(t *testing.T) {
+			reports = append(reports, BenchmarkRelayer(t, ctx, cf, rf, rep, cfg))
+		})
+	}
+
+	var sb strings.Builder
+	if err := WriteBenchmarkReports(&sb, reports); err != nil {
+		t.Logf("failed to write benchmark reports: %v", err)
+	}
+	t.Logf("relayer benchmark:\n%s", sb.String())
+	return reports
+}
+
+// BenchmarkRelayer measures a relayer built from rf between the two Cosmos chains of cf.
+// Packets are sent in both directions on every channel, first while the relayer is running
+// to measure per-packet latency, then as a backlog before the relayer starts to measure the time to clear it.
+// The transactions of the relayer wallets over the whole run measure its batching and gas.
+func BenchmarkRelayer(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter, cfg BenchmarkConfig) BenchmarkReport {
+	rep.TrackTest(t)
+
+	req := require.New(rep.TestifyT(t))
+	req.NoError(cfg.Validate(), "invalid benchmark config")
+
+	client, network := interchaintest.DockerSetup(t)
+
+	chains, err := cf.Chains(t.Name())
+	req.NoError(err, "failed to get chains")
+
+	if len(chains) != 2 {
+		panic(fmt.Errorf("expected 2 chains, got %d", len(chains)))
+	}
+
+	c0, ok0 := chains[0].(*cosmos.CosmosChain)
+	c1, ok1 := chains[1].(*cosmos.CosmosChain)
+	if !ok0 || !ok1 {
+		rep.TrackSkip(t, "skipping due to benchmark requiring cosmos chains")
+	}
+
+	r := rf.Build(t, client, network)
+
+	channelOpts := make([]ibc.CreateChannelOptions, cfg.Channels)
+	for i := range channelOpts {
+		channelOpts[i] = ibc.DefaultChannelOpts()
+	}
+
+	const pathName = "p"
+	ic := interchaintest.NewInterchain().
+		AddChain(c0).
+		AddChain(c1).
+		AddRelayer(r, "r").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  c0,
+			Chain2:  c1,
+			Relayer: r,
+
+			Path:     pathName,
+			Channels: channelOpts,
+		})
+
+	eRep := rep.RelayerExecReporter(t)
+
+	req.NoError(ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	channels, err := r.GetChannels(ctx, eRep, c0.Config().ChainID)
+	req.NoError(err)
+	req.Len(channels, cfg.Channels)
+
+	// One user per channel on each chain, so that channels are loaded independently.
+	users := make([][]ibc.Wallet, len(channels))
+	for i := range channels {
+		users[i] = interchaintest.GetAndFundTestUsers(t, ctx, fmt.Sprintf("bench-%d", i), userFaucetFund, c0, c1)
+	}
+
+	report := BenchmarkReport{
+		Relayer:    rf.Name(),
+		Chains:     []string{c0.Config().ChainID, c1.Config().ChainID},
+		Config:     cfg,
+		RelayerTxs: make(map[string]RelayerTxStats, 2),
+	}
+
+	startHeights := make(map[string]int64, 2)
+	for _, c := range []*cosmos.CosmosChain{c0, c1} {
+		h, err := c.Height(ctx)
+		req.NoError(err)
+		startHeights[c.Config().ChainID] = h
+	}
+
+	// Latency of packets relayed as they are sent.
+	req.NoError(r.StartRelayer(ctx, eRep, pathName))
+	txs0, txs1 := sendBenchmarkTransfers(ctx, t, rep, c0, c1, channels, users, cfg.Packets)
+	for _, sent := range []struct {
+		chain *cosmos.CosmosChain
+		txs   []ibc.Tx
+	}{{c0, txs0}, {c1, txs1}} {
+		ackHeights, err := pollForAckHeights(ctx, sent.chain, sent.txs)
+		req.NoError(err, "failed to get acknowledgements on %s", sent.chain.Config().ChainID)
+
+		for _, tx := range sent.txs {
+			sendTime, err := blockTime(ctx, sent.chain, tx.Height)
+			req.NoError(err)
+			ackTime, err := blockTime(ctx, sent.chain, ackHeights[packetKeyOf(tx.Packet)])
+			req.NoError(err)
+			report.Latencies = append(report.Latencies, ackTime.Sub(sendTime))
+		}
+	}
+	req.NoError(r.StopRelayer(ctx, eRep))
+
+	// Time to clear a backlog of packets sent while the relayer is stopped.
+	txs0, txs1 = sendBenchmarkTransfers(ctx, t, rep, c0, c1, channels, users, cfg.Packets)
+	req.NoError(r.StartRelayer(ctx, eRep, pathName))
+	t.Cleanup(func() {
+		if err := r.StopRelayer(ctx, eRep); err != nil {
+			t.Logf("error stopping relayer: %v", err)
+		}
+	})
+	backlogStartTimes := make(map[string]time.Time, 2)
+	for _, c := range []*cosmos.CosmosChain{c0, c1} {
+		h, err := c.Height(ctx)
+		req.NoError(err)
+		backlogStartTimes[c.Config().ChainID], err = blockTime(ctx, c, h)
+		req.NoError(err)
+	}
+	for _, sent := range []struct {
+		chain *cosmos.CosmosChain
+		txs   []ibc.Tx
+	}{{c0, txs0}, {c1, txs1}} {
+		ackHeights, err := pollForAckHeights(ctx, sent.chain, sent.txs)
+		req.NoError(err, "failed to get acknowledgements on %s", sent.chain.Config().ChainID)
+
+		var lastHeight int64
+		for _, h := range ackHeights {
+			lastHeight = max(lastHeight, h)
+		}
+		lastTime, err := blockTime(ctx, sent.chain, lastHeight)
+		req.NoError(err)
+		report.BacklogClearTime = max(report.BacklogClearTime, lastTime.Sub(backlogStartTimes[sent.chain.Config().ChainID]))
+	}
+
+	for _, c := range []*cosmos.CosmosChain{c0, c1} {
+		chainID := c.Config().ChainID
+		wallet, ok := r.GetWallet(chainID)
+		req.True(ok, "relayer has no wallet on %s", chainID)
+
+		endHeight, err := c.Height(ctx)
+		req.NoError(err)
+		stats, err := relayerTxStats(ctx, c, wallet.FormattedAddress(), startHeights[chainID], endHeight)
+		req.NoError(err, "failed to get relayer transactions on %s", chainID)
+		report.RelayerTxs[chainID] = stats
+	}
+
+	return report
+}
+
+// sendBenchmarkTransfers sends packets transfers in both directions on every channel at the same time,
+// returning the transactions sent on each chain.
+func sendBenchmarkTransfers(
+	ctx context.Context,
+	t *testing.T,
+	rep *testreporter.Reporter,
+	c0, c1 *cosmos.CosmosChain,
+	channels []ibc.ChannelOutput,
+	users [][]ibc.Wallet,
+	packets int,
+) ([]ibc.Tx, []ibc.Tx) {
+	var eg errgroup.Group
+	txs0 := make([][]ibc.Tx, len(channels))
+	txs1 := make([][]ibc.Tx, len(channels))
+	for i, channel := range channels {
+		i, channel := i, channel
+		eg.Go(func() error {
+			for j := 0; j < packets; j++ {
+				tx, err := c0.SendIBCTransfer(ctx, channel.ChannelID, users[i][0].KeyName(), ibc.WalletAmount{
+					Address: addressOn(users[i][0], c1),
+					Denom:   c0.Config().Denom,
+					Amount:  testCoinAmount,
+				}, ibc.TransferOptions{})
+				if err != nil {
+					return fmt.Errorf("failed to send ibc transfer from %s: %w", c0.Config().ChainID, err)
+				}
+				txs0[i] = append(txs0[i], tx)
+			}
+			return nil
+		})
+		eg.Go(func() error {
+			for j := 0; j < packets; j++ {
+				tx, err := c1.SendIBCTransfer(ctx, channel.Counterparty.ChannelID, users[i][1].KeyName(), ibc.WalletAmount{
+					Address: addressOn(users[i][1], c0),
+					Denom:   c1.Config().Denom,
+					Amount:  testCoinAmount,
+				}, ibc.TransferOptions{})
+				if err != nil {
+					return fmt.Errorf("failed to send ibc transfer from %s: %w", c1.Config().ChainID, err)
+				}
+				txs1[i] = append(txs1[i], tx)
+			}
+			return nil
+		})
+	}
+	require.NoError(rep.TestifyT(t), eg.Wait())
+
+	var all0, all1 []ibc.Tx
+	for i := range channels {
+		all0 = append(all0, txs0[i]...)
+		all1 = append(all1, txs1[i]...)
+	}
+	return all0, all1
+}
+
+// packetKey identifies a packet sent by a chain.
+type packetKey struct {
+	SourcePort    string
+	SourceChannel string
+	Sequence      uint64
+}
+
+func packetKeyOf(p ibc.Packet) packetKey {
+	return packetKey{SourcePort: p.SourcePort, SourceChannel: p.SourceChannel, Sequence: p.Sequence}
+}
+
+// pollForAckHeights returns the height at which the packet of each tx was acknowledged on chain, the chain that sent it.
+// An error is returned if some packets are not acknowledged within pollHeightMax blocks of the last tx.
+func pollForAckHeights(ctx context.Context, chain *cosmos.CosmosChain, txs []ibc.Tx) (map[packetKey]int64, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	pending := make(map[packetKey]bool, len(txs))
+	startHeight, maxHeight := txs[0].Height, txs[0].Height
+	for _, tx := range txs {
+		pending[packetKeyOf(tx.Packet)] = true
+		startHeight = min(startHeight, tx.Height)
+		maxHeight = max(maxHeight, tx.Height)
+	}
+	maxHeight += pollHeightMax
+
+	heights := make(map[packetKey]int64, len(txs))
+	for h := startHeight; len(pending) > 0; {
+		if h > maxHeight {
+			return heights, fmt.Errorf("%d packets not acknowledged by height %d", len(pending), maxHeight)
+		}
+		cur, err := chain.Height(ctx)
+		if err != nil {
+			return heights, err
+		}
+		if h > cur {
+			if err := testutil.WaitForBlocks(ctx, 1, chain); err != nil {
+				return heights, err
+			}
+			continue
+		}
+
+		acks, err := chain.Acknowledgements(ctx, h)
+		if err != nil {
+			return heights, fmt.Errorf("failed to get acknowledgements at height %d: %w", h, err)
+		}
+		for _, ack := range acks {
+			key := packetKeyOf(ack.Packet)
+			if pending[key] {
+				delete(pending, key)
+				heights[key] = h
+			}
+		}
+		h++
+	}
+	return heights, nil
+}
+
+// blockTime returns the time of the block of chain at height.
+func blockTime(ctx context.Context, chain *cosmos.CosmosChain, height int64) (time.Time, error) {
+	res, err := chain.GetNode().Client.Block(ctx, &height)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get block at height %d: %w", height, err)
+	}
+	return res.Block.Time, nil
+}
+
+// relayerTxStats returns the transactions sent by the relayer wallet addr to chain between startHeight and endHeight.
+func relayerTxStats(ctx context.Context, chain *cosmos.CosmosChain, addr string, startHeight, endHeight int64) (RelayerTxStats, error) {
+	var (
+		stats   RelayerTxStats
+		decoder = chain.Config().EncodingConfig.TxConfig.TxDecoder()
+		query   = fmt.Sprintf("message.sender='%s' AND tx.height>=%d AND tx.height<=%d", addr, startHeight, endHeight)
+		page    = 1
+		perPage = 100
+	)
+	for {
+		res, err := chain.GetNode().Client.TxSearch(ctx, query, false, &page, &perPage, "asc")
+		if err != nil {
+			return stats, err
+		}
+		for _, tx := range res.Txs {
+			sdkTx, err := decoder(tx.Tx)
+			if err != nil {
+				return stats, fmt.Errorf("failed to decode tx %s: %w", tx.Hash, err)
+			}
+			stats.Txs++
+			stats.Msgs += len(sdkTx.GetMsgs())
+			stats.GasUsed += tx.TxResult.GasUsed
+		}
+		if page*perPage >= res.TotalCount {
+			return stats, nil
+		}
+		page++
+	}
+}
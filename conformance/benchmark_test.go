@@ -0,0 +1,47 @@
+package conformance_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/conformance"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchmarkReport(t *testing.T) {
+	report := conformance.BenchmarkReport{
+		Relayer: "rly",
+		Chains:  []string{"chain-a", "chain-b"},
+		Config:  conformance.BenchmarkConfig{Channels: 2, Packets: 5},
+		Latencies: []time.Duration{
+			4 * time.Second, 1 * time.Second, 3 * time.Second, 2 * time.Second,
+		},
+		BacklogClearTime: 12 * time.Second,
+		RelayerTxs: map[string]conformance.RelayerTxStats{
+			"chain-a": {Txs: 4, Msgs: 10, GasUsed: 400_000},
+		},
+	}
+
+	require.Equal(t, 2*time.Second, report.LatencyPercentile(50))
+	require.Equal(t, 4*time.Second, report.LatencyPercentile(95))
+	require.Equal(t, 4*time.Second, report.LatencyPercentile(100))
+	require.Equal(t, 1*time.Second, report.LatencyPercentile(0))
+	require.Zero(t, conformance.BenchmarkReport{}.LatencyPercentile(50))
+
+	require.Equal(t, 2.5, report.RelayerTxs["chain-a"].MsgsPerTx())
+	require.Zero(t, report.RelayerTxs["chain-b"].MsgsPerTx())
+
+	var sb strings.Builder
+	require.NoError(t, conformance.WriteBenchmarkReports(&sb, []conformance.BenchmarkReport{report}))
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []string{"rly", "chain-a", "20", "2s", "4s", "4s", "12s", "4", "2.50", "400000"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"rly", "chain-b", "20", "2s", "4s", "4s", "12s", "0", "0.00", "0"}, strings.Fields(lines[2]))
+}
+
+func TestBenchmarkConfig_Validate(t *testing.T) {
+	require.NoError(t, conformance.DefaultBenchmarkConfig().Validate())
+	require.EqualError(t, conformance.BenchmarkConfig{Channels: 0, Packets: 10}.Validate(), "benchmark needs at least 1 channel, got 0")
+	require.EqualError(t, conformance.BenchmarkConfig{Channels: 2, Packets: 0}.Validate(), "benchmark needs at least 1 packet, got 0")
+}
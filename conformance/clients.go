@@ -0,0 +1,94 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// idleBlocks is the number of blocks both chains produce while the relayer is idle.
+const idleBlocks = 30
+
+// TestRelayerClientUpdate asserts that the relayer updates the clients of a path
+// that has been idle for a while, and then relays a packet over it.
+func TestRelayerClientUpdate(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.ClientUpdate)
+
+	const pathName = "p"
+	c0, c1, r := startChainPair(t, ctx, cf, rf, rep, pathName)
+
+	req := require.New(rep.TestifyT(t))
+	eRep := rep.RelayerExecReporter(t)
+
+	t.Run("update after idle", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		req := require.New(rep.TestifyT(t))
+
+		before0 := clientHeights(ctx, t, rep, r, c0)
+		before1 := clientHeights(ctx, t, rep, r, c1)
+
+		req.NoError(testutil.WaitForBlocks(ctx, idleBlocks, c0, c1), "failed to wait for blocks")
+		req.NoError(r.UpdateClients(ctx, rep.RelayerExecReporter(t), pathName))
+
+		for clientID, after := range clientHeights(ctx, t, rep, r, c0) {
+			req.Greater(after, before0[clientID], "client %s on %s was not updated", clientID, c0.Config().ChainID)
+		}
+		for clientID, after := range clientHeights(ctx, t, rep, r, c1) {
+			req.Greater(after, before1[clientID], "client %s on %s was not updated", clientID, c1.Config().ChainID)
+		}
+	})
+	if t.Failed() {
+		return
+	}
+
+	channels, err := r.GetChannels(ctx, eRep, c0.Config().ChainID)
+	req.NoError(err)
+	req.NotEmpty(channels)
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "idle", userFaucetFund, c0, c1)
+
+	req.NoError(testutil.WaitForBlocks(ctx, idleBlocks, c0, c1), "failed to wait for blocks")
+
+	t.Run("relay after idle", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		testCase := &RelayerTestCase{Users: users}
+		sendIBCTransfersFromBothChainsWithTimeout(ctx, t, testCase, c0, c1, channels[:1], nil)
+
+		startRelayer(t, ctx, rep, r, pathName)
+
+		testPacketRelaySuccess(ctx, t, testCase, rep, c0, c1, channels[:1])
+	})
+}
+
+// clientHeights returns the latest revision height of each client on chain known to the relayer.
+// Only Cosmos chains are queried; t is skipped for other chains.
+func clientHeights(ctx context.Context, t *testing.T, rep *testreporter.Reporter, r ibc.Relayer, chain ibc.Chain) map[string]uint64 {
+	c, ok := chain.(*cosmos.CosmosChain)
+	if !ok {
+		rep.TrackSkip(t, "skipping due to client state queries requiring cosmos chains")
+	}
+
+	req := require.New(rep.TestifyT(t))
+
+	clients, err := r.GetClients(ctx, rep.RelayerExecReporter(t), c.Config().ChainID)
+	req.NoError(err)
+
+	heights := make(map[string]uint64, len(clients))
+	for _, client := range clients {
+		cs, err := c.IBCQueryClientState(ctx, client.ClientID)
+		req.NoError(err)
+		heights[client.ClientID] = cs.GetLatestHeight().GetRevisionHeight()
+	}
+	return heights
+}
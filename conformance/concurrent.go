@@ -0,0 +1,98 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// concurrentSenders is the number of users sending a packet at the same time on each chain.
+const concurrentSenders = 5
+
+// TestRelayerConcurrentPackets asserts that the relayer relays packets
+// sent at the same time by several users in both directions.
+func TestRelayerConcurrentPackets(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.ConcurrentPackets)
+
+	const pathName = "p"
+	c0, c1, r := startChainPair(t, ctx, cf, rf, rep, pathName)
+
+	req := require.New(rep.TestifyT(t))
+
+	channels, err := r.GetChannels(ctx, rep.RelayerExecReporter(t), c0.Config().ChainID)
+	req.NoError(err)
+	req.NotEmpty(channels)
+	channel := channels[0]
+
+	users0 := make([]ibc.Wallet, concurrentSenders)
+	users1 := make([]ibc.Wallet, concurrentSenders)
+	for i := range users0 {
+		users := interchaintest.GetAndFundTestUsers(t, ctx, fmt.Sprintf("concurrent-%d", i), userFaucetFund, c0, c1)
+		users0[i], users1[i] = users[0], users[1]
+	}
+
+	startRelayer(t, ctx, rep, r, pathName)
+
+	var eg errgroup.Group
+	txs0 := make([]ibc.Tx, concurrentSenders)
+	txs1 := make([]ibc.Tx, concurrentSenders)
+	for i := 0; i < concurrentSenders; i++ {
+		i := i
+		eg.Go(func() (err error) {
+			txs0[i], err = c0.SendIBCTransfer(ctx, channel.ChannelID, users0[i].KeyName(), ibc.WalletAmount{
+				Address: addressOn(users0[i], c1),
+				Denom:   c0.Config().Denom,
+				Amount:  testCoinAmount,
+			}, ibc.TransferOptions{})
+			return err
+		})
+		eg.Go(func() (err error) {
+			txs1[i], err = c1.SendIBCTransfer(ctx, channel.Counterparty.ChannelID, users1[i].KeyName(), ibc.WalletAmount{
+				Address: addressOn(users1[i], c0),
+				Denom:   c1.Config().Denom,
+				Amount:  testCoinAmount,
+			}, ibc.TransferOptions{})
+			return err
+		})
+	}
+	req.NoError(eg.Wait(), "failed to send concurrent transfers")
+
+	dstDenom0 := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(channel.Counterparty.PortID, channel.Counterparty.ChannelID, c0.Config().Denom)).IBCDenom()
+	dstDenom1 := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(channel.PortID, channel.ChannelID, c1.Config().Denom)).IBCDenom()
+
+	for i := 0; i < concurrentSenders; i++ {
+		req.NoError(txs0[i].Validate())
+		ack, err := testutil.PollForAck(ctx, c0, txs0[i].Height, txs0[i].Height+pollHeightMax, txs0[i].Packet)
+		req.NoError(err, "failed to get acknowledgement on %s", c0.Config().ChainID)
+		req.NoError(ack.Validate())
+
+		req.NoError(txs1[i].Validate())
+		ack, err = testutil.PollForAck(ctx, c1, txs1[i].Height, txs1[i].Height+pollHeightMax, txs1[i].Packet)
+		req.NoError(err, "failed to get acknowledgement on %s", c1.Config().ChainID)
+		req.NoError(ack.Validate())
+	}
+
+	// Even though we poll for the acks, balances may not be fully reconciled yet.
+	req.NoError(testutil.WaitForBlocks(ctx, 5, c0, c1))
+
+	for i := 0; i < concurrentSenders; i++ {
+		bal, err := c1.GetBalance(ctx, addressOn(users0[i], c1), dstDenom0)
+		req.NoError(err)
+		req.True(bal.Equal(testCoinAmount), "unexpected balance %s on %s", bal, c1.Config().ChainID)
+
+		bal, err = c0.GetBalance(ctx, addressOn(users1[i], c0), dstDenom1)
+		req.NoError(err)
+		req.True(bal.Equal(testCoinAmount), "unexpected balance %s on %s", bal, c0.Config().ChainID)
+	}
+}
@@ -0,0 +1,134 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRelayerOrderedChannels asserts that the relayer relays packets on an ordered interchain accounts channel,
+// and completes the close handshake of the channel once one of its packets times out.
+// The first chain of cf controls an interchain account on the second, so both must be Cosmos chains
+// with the interchain accounts modules.
+func TestRelayerOrderedChannels(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.OrderedChannels)
+
+	const pathName = "p"
+	c0, c1, r := startChainPair(t, ctx, cf, rf, rep, pathName)
+
+	controller, ok0 := c0.(*cosmos.CosmosChain)
+	host, ok1 := c1.(*cosmos.CosmosChain)
+	if !ok0 || !ok1 {
+		rep.TrackSkip(t, "skipping due to interchain accounts requiring cosmos chains")
+	}
+
+	req := require.New(rep.TestifyT(t))
+	eRep := rep.RelayerExecReporter(t)
+
+	connections, err := r.GetConnections(ctx, eRep, controller.Config().ChainID)
+	req.NoError(err)
+	req.NotEmpty(connections)
+	connectionID := connections[0].ID
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "ordered", userFaucetFund, controller, host)
+	owner, recipient := users[0], users[1]
+
+	startRelayer(t, ctx, rep, r, pathName)
+
+	_, err = controller.GetNode().RegisterICAWithOrdering(ctx, owner.KeyName(), connectionID, ibc.Ordered)
+	req.NoError(err)
+
+	h, err := controller.Height(ctx)
+	req.NoError(err)
+	channel, err := cosmos.PollForICAChannelOpen(ctx, controller, h, h+pollHeightMax, connectionID, owner.FormattedAddress())
+	req.NoError(err, "interchain accounts channel was not opened")
+	req.Equal(chantypes.ORDERED, channel.Ordering)
+
+	icaAddr, err := controller.QueryICAAddress(ctx, connectionID, owner.FormattedAddress())
+	req.NoError(err)
+
+	hostDenom := host.Config().Denom
+	req.NoError(host.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+		Address: icaAddr,
+		Denom:   hostDenom,
+		Amount:  testCoinAmount.MulRaw(2),
+	}))
+
+	// The message is executed by the interchain account on the host chain.
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: icaAddr,
+		ToAddress:   recipient.FormattedAddress(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(hostDenom, testCoinAmount)),
+	}
+
+	t.Run("relay", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		req := require.New(rep.TestifyT(t))
+
+		before, err := host.GetBalance(ctx, recipient.FormattedAddress(), hostDenom)
+		req.NoError(err)
+
+		_, err = controller.SendICATx(ctx, owner.KeyName(), connectionID, []sdk.Msg{sendMsg}, "")
+		req.NoError(err)
+
+		req.NoError(cosmos.PollForBalance(ctx, host, pollHeightMax, ibc.WalletAmount{
+			Address: recipient.FormattedAddress(),
+			Denom:   hostDenom,
+			Amount:  before.Add(testCoinAmount),
+		}), "interchain accounts packet was not relayed")
+	})
+	if t.Failed() {
+		return
+	}
+
+	t.Run("close on timeout", func(t *testing.T) {
+		rep.TrackTest(t)
+		requireCapabilities(t, rep, rf, relayer.ChannelClose)
+
+		req := require.New(rep.TestifyT(t))
+
+		packetData, err := cosmos.NewICAPacketData(controller.Config().EncodingConfig.InterfaceRegistry, []sdk.Msg{sendMsg}, "")
+		req.NoError(err)
+		packetJSON, err := controller.Config().EncodingConfig.Codec.MarshalJSON(&packetData)
+		req.NoError(err)
+
+		// A relative timeout of one nanosecond has passed by the time the packet reaches the host,
+		// so the relayer times it out, closing the ordered channel on the controller.
+		_, err = controller.GetNode().ExecTx(ctx, owner.KeyName(),
+			"interchain-accounts", "controller", "send-tx", connectionID, string(packetJSON),
+			"--relative-packet-timeout", "1",
+		)
+		req.NoError(err)
+
+		h, err := controller.Height(ctx)
+		req.NoError(err)
+		req.NoError(pollForChannelState(ctx, controller, h, h+pollHeightMax, channel.PortId, channel.ChannelId, chantypes.CLOSED),
+			"channel was not closed on the controller chain")
+
+		h, err = host.Height(ctx)
+		req.NoError(err)
+		req.NoError(pollForChannelState(ctx, host, h, h+pollHeightMax, channel.Counterparty.PortId, channel.Counterparty.ChannelId, chantypes.CLOSED),
+			"channel was not closed on the host chain")
+
+		// The interchain account remains usable over a new channel.
+		_, err = controller.RecoverICA(ctx, owner.KeyName(), connectionID, ibc.Ordered)
+		req.NoError(err)
+
+		h, err = controller.Height(ctx)
+		req.NoError(err)
+		_, err = cosmos.PollForICAChannelOpen(ctx, controller, h, h+pollHeightMax, connectionID, owner.FormattedAddress())
+		req.NoError(err, "interchain accounts channel was not reopened")
+	})
+}
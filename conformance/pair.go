@@ -0,0 +1,90 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// startChainPair starts the two chains of cf linked over pathName by a relayer built from rf,
+// with a single transfer channel. The relayer is not started.
+func startChainPair(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter, pathName string) (ibc.Chain, ibc.Chain, ibc.Relayer) {
+	client, network := interchaintest.DockerSetup(t)
+
+	req := require.New(rep.TestifyT(t))
+	chains, err := cf.Chains(t.Name())
+	req.NoError(err, "failed to get chains")
+
+	if len(chains) != 2 {
+		panic(fmt.Errorf("expected 2 chains, got %d", len(chains)))
+	}
+
+	c0, c1 := chains[0], chains[1]
+
+	r := rf.Build(t, client, network)
+
+	ic := interchaintest.NewInterchain().
+		AddChain(c0).
+		AddChain(c1).
+		AddRelayer(r, "r").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  c0,
+			Chain2:  c1,
+			Relayer: r,
+
+			Path:              pathName,
+			CreateChannelOpts: ibc.DefaultChannelOpts(),
+		})
+
+	req.NoError(ic.Build(ctx, rep.RelayerExecReporter(t), interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	return c0, c1, r
+}
+
+// startRelayer starts r on pathName and stops it when t completes.
+func startRelayer(t *testing.T, ctx context.Context, rep *testreporter.Reporter, r ibc.Relayer, pathName string) {
+	eRep := rep.RelayerExecReporter(t)
+	require.NoError(rep.TestifyT(t), r.StartRelayer(ctx, eRep, pathName), "failed to start relayer")
+	t.Cleanup(func() {
+		if err := r.StopRelayer(ctx, eRep); err != nil {
+			t.Logf("error stopping relayer: %v", err)
+		}
+	})
+}
+
+// addressOn returns the address of the Cosmos wallet w on chain.
+func addressOn(w ibc.Wallet, chain ibc.Chain) string {
+	return w.(*cosmos.CosmosWallet).FormattedAddressWithPrefix(chain.Config().Bech32Prefix)
+}
+
+// pollForChannelState polls until the channel on chain is in the given state.
+func pollForChannelState(ctx context.Context, chain *cosmos.CosmosChain, startHeight, maxHeight int64, portID, channelID string, state chantypes.State) error {
+	doPoll := func(ctx context.Context, height int64) (*chantypes.Channel, error) {
+		ch, err := chain.IBCQueryChannel(ctx, portID, channelID)
+		if err != nil {
+			return nil, err
+		}
+		if ch.State != state {
+			return nil, fmt.Errorf("channel %s/%s state (%s) does not match expected: (%s)", portID, channelID, ch.State, state)
+		}
+		return ch, nil
+	}
+	bp := testutil.BlockPoller[*chantypes.Channel]{CurrentHeight: chain.Height, PollFunc: doPoll}
+	_, err := bp.DoPoll(ctx, startHeight, maxHeight)
+	return err
+}
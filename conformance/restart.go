@@ -0,0 +1,62 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// pendingRounds is the number of rounds of transfers queued in both directions before the relayer starts.
+const pendingRounds = 5
+
+// TestRelayerRestart asserts that the relayer relays every pending packet
+// after being restarted while it was still relaying them.
+func TestRelayerRestart(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.RestartRecovery)
+
+	const pathName = "p"
+	c0, c1, r := startChainPair(t, ctx, cf, rf, rep, pathName)
+
+	req := require.New(rep.TestifyT(t))
+	eRep := rep.RelayerExecReporter(t)
+
+	channels, err := r.GetChannels(ctx, eRep, c0.Config().ChainID)
+	req.NoError(err)
+	req.NotEmpty(channels)
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "restart", userFaucetFund, c0, c1)
+
+	// Queue packets in both directions while the relayer is stopped.
+	testCase := &RelayerTestCase{Users: users}
+	var pending TxCache
+	for i := 0; i < pendingRounds; i++ {
+		sendIBCTransfersFromBothChainsWithTimeout(ctx, t, testCase, c0, c1, channels[:1], nil)
+		pending.Src = append(pending.Src, testCase.TxCache.Src...)
+		pending.Dst = append(pending.Dst, testCase.TxCache.Dst...)
+	}
+
+	// Stop the relayer shortly after it starts relaying the pending packets.
+	req.NoError(r.StartRelayer(ctx, eRep, pathName))
+	req.NoError(testutil.WaitForBlocks(ctx, 1, c0, c1))
+	req.NoError(r.StopRelayer(ctx, eRep))
+
+	startRelayer(t, ctx, rep, r, pathName)
+
+	for _, tx := range pending.Src {
+		ack, err := testutil.PollForAck(ctx, c0, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+		req.NoError(err, "failed to get acknowledgement on %s", c0.Config().ChainID)
+		req.NoError(ack.Validate())
+	}
+	for _, tx := range pending.Dst {
+		ack, err := testutil.PollForAck(ctx, c1, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+		req.NoError(err, "failed to get acknowledgement on %s", c1.Config().ChainID)
+		req.NoError(ack.Validate())
+	}
+}
@@ -263,6 +263,34 @@ func Test(t *testing.T, ctx context.Context, cfs []interchaintest.ChainFactory,
 
 								TestRelayerFlushing(t, ctx, cf, rf, rep)
 							})
+
+							t.Run("ordered channels", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerOrderedChannels(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("client update", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerClientUpdate(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("concurrent packets", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerConcurrentPackets(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("restart", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerRestart(t, ctx, cf, rf, rep)
+							})
 						})
 					}
 				})
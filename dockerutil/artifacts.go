@@ -0,0 +1,296 @@
+package dockerutil
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// ArtifactsDir is the root directory into which DockerCleanup writes
+// the logs and configuration files of every container belonging to a test.
+// Each test gets its own subdirectory, named after the sanitized test name.
+//
+// The value is empty by default, which disables artifact collection,
+// but can be initialized by setting the environment variable ICTEST_ARTIFACTS_DIR.
+// The public API for setting this value is interchaintest.CollectArtifactsOnFailure(string).
+var ArtifactsDir = os.Getenv("ICTEST_ARTIFACTS_DIR")
+
+// AlwaysCollectArtifacts determines whether artifacts are collected
+// for passing tests too, rather than only following a test failure.
+//
+// The value is false by default, but can be initialized to true by setting the
+// environment variable ICTEST_ARTIFACTS_ALWAYS to a non-empty value.
+// The public API for setting this value is interchaintest.CollectArtifactsAlways(bool).
+var AlwaysCollectArtifacts = os.Getenv("ICTEST_ARTIFACTS_ALWAYS") != ""
+
+// artifactConfigPaths are paths, relative to each volume mounted into a container,
+// whose contents are copied into the artifacts directory.
+// These cover chain node homes (genesis.json, config.toml, app.toml, ...)
+// and the homes of the supported relayers.
+var artifactConfigPaths = []string{
+	"config",
+	".relayer/config",
+	".hermes",
+	".hyperspace",
+}
+
+// savedArtifactsDir is the directory of ArtifactsDir holding the artifacts saved by SaveContainerArtifacts
+// until DockerCleanup collects or discards them.
+const savedArtifactsDir = ".saved"
+
+// maxArtifactFileSize bounds the size of any single configuration file copied out of a container,
+// so that an unexpectedly large file does not fill the CI disk.
+const maxArtifactFileSize = 32 << 20
+
+// shouldCollectArtifacts reports whether DockerCleanup should collect artifacts for t.
+func shouldCollectArtifacts(t DockerSetupTestingT) bool {
+	return ArtifactsDir != "" && (AlwaysCollectArtifacts || t.Failed())
+}
+
+// CollectArtifacts writes the full stdout and stderr logs, the inspect output,
+// and copies of configuration files (such as genesis.json and config.toml)
+// of every container labelled with t's name into a subdirectory of dir,
+// along with the artifacts saved by SaveContainerArtifacts for containers already removed.
+//
+// CollectArtifacts is best effort: failures are logged to t and do not stop collection
+// for the remaining containers. It returns the test's artifact directory.
+func CollectArtifacts(ctx context.Context, t DockerSetupTestingT, cli *client.Client, dir string) string {
+	testDir := filepath.Join(dir, SanitizeContainerName(t.Name()))
+
+	cs, err := cli.ContainerList(ctx, types.ContainerListOptions{
+		All: true,
+		Filters: filters.NewArgs(
+			filters.Arg("label", CleanupLabel+"="+t.Name()),
+		),
+	})
+	if err != nil {
+		t.Logf("Failed to list containers while collecting artifacts: %v", err)
+		return testDir
+	}
+
+	for _, c := range cs {
+		name := containerName(c)
+		if err := collectContainer(ctx, cli, c, filepath.Join(testDir, name)); err != nil {
+			t.Logf("Failed to collect artifacts of container %s: %v", name, err)
+		}
+	}
+
+	// Containers removed before the cleanup, such as stopped relayers, saved their artifacts beforehand.
+	saved := filepath.Join(dir, savedArtifactsDir, SanitizeContainerName(t.Name()))
+	entries, err := os.ReadDir(saved)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		t.Logf("Failed to read saved artifacts: %v", err)
+	}
+	for _, e := range entries {
+		dst := filepath.Join(testDir, e.Name())
+		if _, err := os.Stat(dst); err == nil {
+			dst += "-removed"
+		}
+		if err := os.MkdirAll(testDir, 0o755); err != nil {
+			t.Logf("Failed to create artifact directory %s: %v", testDir, err)
+			break
+		}
+		if err := os.Rename(filepath.Join(saved, e.Name()), dst); err != nil {
+			t.Logf("Failed to move saved artifacts of container %s: %v", e.Name(), err)
+		}
+	}
+	_ = os.RemoveAll(saved)
+
+	if n := len(cs) + len(entries); n > 0 {
+		t.Logf("Collected artifacts of %d container(s) in %s", n, testDir)
+	}
+
+	return testDir
+}
+
+// SaveContainerArtifacts saves the artifacts of a container of the test about to be removed,
+// so that CollectArtifacts, run by DockerCleanup once the test ends, can still include them.
+// Artifacts saved for a test that does not collect them are discarded by DockerCleanup.
+// It does nothing unless ArtifactsDir is set.
+func SaveContainerArtifacts(ctx context.Context, cli *client.Client, testName, containerID string) error {
+	if ArtifactsDir == "" {
+		return nil
+	}
+
+	c, err := cli.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("id", containerID)),
+	})
+	if err != nil {
+		return err
+	}
+	if len(c) == 0 {
+		return fmt.Errorf("container %s not found", containerID)
+	}
+
+	name := containerName(c[0])
+	dst := filepath.Join(ArtifactsDir, savedArtifactsDir, SanitizeContainerName(testName), name)
+	// A container restarted under the same name keeps the artifacts of each run.
+	if _, err := os.Stat(dst); err == nil {
+		dst += "-" + containerID[:min(12, len(containerID))]
+	}
+	return collectContainer(ctx, cli, c[0], dst)
+}
+
+// discardSavedArtifacts removes the artifacts saved by SaveContainerArtifacts for a test not collecting them.
+func discardSavedArtifacts(t DockerSetupTestingT) {
+	if ArtifactsDir == "" {
+		return
+	}
+	_ = os.RemoveAll(filepath.Join(ArtifactsDir, savedArtifactsDir, SanitizeContainerName(t.Name())))
+}
+
+// collectContainer writes the logs, inspect output and configuration files of the container into dir.
+// It is best effort, returning the errors of every failed step.
+func collectContainer(ctx context.Context, cli *client.Client, c types.Container, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	var errs []error
+	if err := writeContainerLogs(ctx, cli, c.ID, dir); err != nil {
+		errs = append(errs, fmt.Errorf("logs: %w", err))
+	}
+
+	if err := writeContainerInspect(ctx, cli, c.ID, dir); err != nil {
+		errs = append(errs, fmt.Errorf("inspect output: %w", err))
+	}
+
+	for _, m := range c.Mounts {
+		if m.Type != mount.TypeVolume && m.Type != mount.TypeBind {
+			continue
+		}
+		for _, p := range artifactConfigPaths {
+			src := path.Join(m.Destination, p)
+			dst := filepath.Join(dir, "files", filepath.FromSlash(src))
+			if err := copyContainerDir(ctx, cli, c.ID, src, dst); err != nil && !client.IsErrNotFound(err) {
+				errs = append(errs, fmt.Errorf("copy %s: %w", src, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func containerName(c types.Container) string {
+	if len(c.Names) > 0 {
+		return strings.TrimPrefix(c.Names[0], "/")
+	}
+	return c.ID
+}
+
+// writeContainerLogs demultiplexes the complete logs of the container into stdout.log and stderr.log.
+func writeContainerLogs(ctx context.Context, cli *client.Client, containerID, dir string) error {
+	rc, err := cli.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	stdout, err := os.Create(filepath.Join(dir, "stdout.log"))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stdout.Close() }()
+
+	stderr, err := os.Create(filepath.Join(dir, "stderr.log"))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stderr.Close() }()
+
+	// Logs are multiplexed into one stream; see docs for ContainerLogs.
+	if _, err := stdcopy.StdCopy(stdout, stderr, rc); err != nil {
+		return fmt.Errorf("demuxing logs: %w", err)
+	}
+	return nil
+}
+
+// writeContainerInspect writes the container's inspect output to inspect.json,
+// which records the image, command, environment, and exit state.
+func writeContainerInspect(ctx context.Context, cli *client.Client, containerID, dir string) error {
+	cjson, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(cjson, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "inspect.json"), b, 0o644)
+}
+
+// copyContainerDir copies srcPath out of the container, which may be stopped, into dstDir.
+func copyContainerDir(ctx context.Context, cli *client.Client, containerID, srcPath, dstDir string) error {
+	rc, _, err := cli.CopyFromContainer(ctx, containerID, srcPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	return extractTar(rc, dstDir)
+}
+
+// extractTar writes the regular files of the tar stream into dir.
+// The first path element of every entry, which is the base name of the copied directory, is stripped.
+// Entries escaping dir and files larger than maxArtifactFileSize are skipped.
+func extractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || hdr.Size > maxArtifactFileSize {
+			continue
+		}
+
+		name := path.Clean(hdr.Name)
+		if name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			continue
+		}
+		_, rel, found := strings.Cut(name, "/")
+		if !found || rel == "" {
+			continue
+		}
+
+		dst := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return err
+		}
+		if err := writeFileFrom(dst, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func writeFileFrom(dst string, r io.Reader) error {
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,67 @@
+package dockerutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractTar(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	write := func(name string, typ byte, content string) {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: typ,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	write("config/", tar.TypeDir, "")
+	write("config/genesis.json", tar.TypeReg, `{"chain_id":"gaia-1"}`)
+	write("config/nested/app.toml", tar.TypeReg, "minimum-gas-prices = \"\"")
+	write("config/../../escape.txt", tar.TypeReg, "nope")
+	write("config/link", tar.TypeSymlink, "")
+	require.NoError(t, tw.Close())
+
+	dir := t.TempDir()
+	require.NoError(t, extractTar(&buf, dir))
+
+	b, err := os.ReadFile(filepath.Join(dir, "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, `{"chain_id":"gaia-1"}`, string(b))
+
+	b, err = os.ReadFile(filepath.Join(dir, "nested", "app.toml"))
+	require.NoError(t, err)
+	require.Equal(t, "minimum-gas-prices = \"\"", string(b))
+
+	require.NoFileExists(t, filepath.Join(filepath.Dir(dir), "escape.txt"))
+	require.NoFileExists(t, filepath.Join(dir, "escape.txt"))
+	require.NoFileExists(t, filepath.Join(dir, "link"))
+}
+
+func TestDiscardSavedArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	defer func(old string) { ArtifactsDir = old }(ArtifactsDir)
+	ArtifactsDir = dir
+
+	saved := filepath.Join(dir, savedArtifactsDir, SanitizeContainerName(t.Name()), "rly-test")
+	other := filepath.Join(dir, savedArtifactsDir, "OtherTest", "rly-test")
+	for _, d := range []string{saved, other} {
+		require.NoError(t, os.MkdirAll(d, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(d, "stdout.log"), []byte("relayed"), 0o644))
+	}
+
+	discardSavedArtifacts(t)
+
+	require.NoDirExists(t, filepath.Dir(saved))
+	require.FileExists(t, filepath.Join(other, "stdout.log"))
+}
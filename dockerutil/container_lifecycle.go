@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	dockertypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -18,12 +19,29 @@ import (
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 )
 
+// Container lifecycle events reported to a ContainerEventReporter.
+const (
+	ContainerEventCreate  = "create"
+	ContainerEventStart   = "start"
+	ContainerEventPause   = "pause"
+	ContainerEventUnpause = "unpause"
+	ContainerEventStop    = "stop"
+	ContainerEventRemove  = "remove"
+)
+
+// ContainerEventReporter is the interface of a narrow type returned by testreporter.RelayerExecReporter,
+// used to record lifecycle changes of long-running containers.
+type ContainerEventReporter interface {
+	TrackContainerEvent(containerName, event string, when time.Time, err error)
+}
+
 type ContainerLifecycle struct {
 	log               *zap.Logger
 	client            *dockerclient.Client
 	containerName     string
 	id                string
 	preStartListeners Listeners
+	reporter          ContainerEventReporter
 }
 
 func NewContainerLifecycle(log *zap.Logger, client *dockerclient.Client, containerName string) *ContainerLifecycle {
@@ -34,6 +52,21 @@ func NewContainerLifecycle(log *zap.Logger, client *dockerclient.Client, contain
 	}
 }
 
+// SetEventReporter sets the reporter notified of every lifecycle change of the container.
+// A nil reporter disables reporting.
+func (c *ContainerLifecycle) SetEventReporter(rep ContainerEventReporter) {
+	c.reporter = rep
+}
+
+// track reports the outcome of a lifecycle event, if a reporter is set,
+// and returns err unchanged.
+func (c *ContainerLifecycle) track(event string, err error) error {
+	if c.reporter != nil {
+		c.reporter.TrackContainerEvent(c.containerName, event, time.Now(), err)
+	}
+	return err
+}
+
 func (c *ContainerLifecycle) CreateContainer(
 	ctx context.Context,
 	testName string,
@@ -55,7 +88,7 @@ func (c *ContainerLifecycle) CreateContainer(
 	)
 
 	if err := image.PullImage(ctx, c.client); err != nil {
-		return err
+		return c.track(ContainerEventCreate, err)
 	}
 
 	pS := nat.PortSet{}
@@ -65,7 +98,7 @@ func (c *ContainerLifecycle) CreateContainer(
 
 	pb, listeners, err := GeneratePortBindings(ports)
 	if err != nil {
-		return fmt.Errorf("failed to generate port bindings: %w", err)
+		return c.track(ContainerEventCreate, fmt.Errorf("failed to generate port bindings: %w", err))
 	}
 
 	c.preStartListeners = listeners
@@ -104,9 +137,21 @@ func (c *ContainerLifecycle) CreateContainer(
 	if err != nil {
 		listeners.CloseAll()
 		c.preStartListeners = []net.Listener{}
-		return err
+		return c.track(ContainerEventCreate, err)
 	}
 	c.id = cc.ID
+	return c.track(ContainerEventCreate, nil)
+}
+
+// AttachContainer uses the existing container with the lifecycle's name, such as one kept stopped
+// by a previous process, instead of creating a new one. The container keeps its configuration,
+// including its host port bindings.
+func (c *ContainerLifecycle) AttachContainer(ctx context.Context) error {
+	cjson, err := c.client.ContainerInspect(ctx, c.containerName)
+	if err != nil {
+		return fmt.Errorf("inspect container %s: %w", c.containerName, err)
+	}
+	c.id = cjson.ID
 	return nil
 }
 
@@ -120,20 +165,20 @@ func (c *ContainerLifecycle) StartContainer(ctx context.Context) error {
 	c.preStartListeners = []net.Listener{}
 
 	if err := StartContainer(ctx, c.client, c.id); err != nil {
-		return err
+		return c.track(ContainerEventStart, err)
 	}
 
 	c.log.Info("Container started", zap.String("container", c.containerName))
 
-	return nil
+	return c.track(ContainerEventStart, nil)
 }
 
 func (c *ContainerLifecycle) PauseContainer(ctx context.Context) error {
-	return c.client.ContainerPause(ctx, c.id)
+	return c.track(ContainerEventPause, c.client.ContainerPause(ctx, c.id))
 }
 
 func (c *ContainerLifecycle) UnpauseContainer(ctx context.Context) error {
-	return c.client.ContainerUnpause(ctx, c.id)
+	return c.track(ContainerEventUnpause, c.client.ContainerUnpause(ctx, c.id))
 }
 
 func (c *ContainerLifecycle) StopContainer(ctx context.Context) error {
@@ -141,7 +186,7 @@ func (c *ContainerLifecycle) StopContainer(ctx context.Context) error {
 	timeoutSec := 30
 	timeout.Timeout = &timeoutSec
 
-	return c.client.ContainerStop(ctx, c.id, timeout)
+	return c.track(ContainerEventStop, c.client.ContainerStop(ctx, c.id, timeout))
 }
 
 func (c *ContainerLifecycle) RemoveContainer(ctx context.Context) error {
@@ -150,9 +195,9 @@ func (c *ContainerLifecycle) RemoveContainer(ctx context.Context) error {
 		RemoveVolumes: true,
 	})
 	if err != nil && !errdefs.IsNotFound(err) {
-		return fmt.Errorf("remove container %s: %w", c.containerName, err)
+		return c.track(ContainerEventRemove, fmt.Errorf("remove container %s: %w", c.containerName, err))
 	}
-	return nil
+	return c.track(ContainerEventRemove, nil)
 }
 
 func (c *ContainerLifecycle) ContainerID() string {
@@ -171,6 +216,11 @@ func (c *ContainerLifecycle) GetHostPorts(ctx context.Context, portIDs ...string
 	return ports, nil
 }
 
+// StreamLogs streams the container's parsed logs; see StreamContainerLogs.
+func (c *ContainerLifecycle) StreamLogs(ctx context.Context, opts LogStreamOptions) (<-chan LogLine, error) {
+	return StreamContainerLogs(ctx, c.client, c.id, opts)
+}
+
 // Running will inspect the container and check its state to determine if it is currently running.
 // If the container is running nil will be returned, otherwise an error is returned.
 func (c *ContainerLifecycle) Running(ctx context.Context) error {
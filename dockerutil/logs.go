@@ -0,0 +1,277 @@
+package dockerutil
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// FatalLogPattern matches log lines that indicate a node crashed or halted,
+// for use with AssertNoLog.
+var FatalLogPattern = regexp.MustCompile(`panic|CONSENSUS FAILURE`)
+
+// Stream names reported in LogLine.Stream.
+const (
+	LogStreamStdout = "stdout"
+	LogStreamStderr = "stderr"
+)
+
+// LogLine is a single line of container output.
+//
+// Both structured JSON lines (as produced by zerolog or zap)
+// and plain text lines (as produced by the cosmos-sdk console logger) are parsed
+// on a best effort basis; Raw always holds the original line.
+type LogLine struct {
+	// When docker received the line.
+	Time time.Time
+
+	// LogStreamStdout or LogStreamStderr.
+	Stream string
+
+	// The original line, excluding the docker timestamp and trailing newline.
+	Raw string
+
+	// Lower-cased level, such as "info" or "error", if it could be determined.
+	Level string
+
+	// The log message, without level and fields, if it could be determined.
+	// Otherwise, Message is the line stripped of terminal color codes.
+	Message string
+
+	// Structured fields for JSON lines, or key=value pairs for text lines.
+	Fields map[string]any
+}
+
+// String returns the original line.
+func (l LogLine) String() string {
+	return l.Raw
+}
+
+// LogStreamOptions configures StreamContainerLogs.
+type LogStreamOptions struct {
+	// If set, only lines written at or after Since are streamed.
+	// Otherwise, the container's entire log history is streamed first.
+	Since time.Time
+
+	// If true, new lines are streamed as they are written
+	// until the context is done or the container stops.
+	// Otherwise, the stream ends after the existing lines.
+	Follow bool
+}
+
+// StreamContainerLogs streams the parsed logs of the container with the given ID.
+//
+// The returned channel is closed when ctx is done or the container's log stream ends,
+// e.g. because the container stopped or opts.Follow is false.
+func StreamContainerLogs(ctx context.Context, cli *client.Client, containerID string, opts LogStreamOptions) (<-chan LogLine, error) {
+	logOpts := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+		Timestamps: true,
+	}
+	if !opts.Since.IsZero() {
+		logOpts.Since = opts.Since.Format(time.RFC3339Nano)
+	}
+
+	rc, err := cli.ContainerLogs(ctx, containerID, logOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream container logs: %w", err)
+	}
+
+	out := make(chan LogLine, 256)
+	stdoutR, stdoutW := io.Pipe()
+	stderrR, stderrW := io.Pipe()
+
+	go func() {
+		// Logs are multiplexed into one stream; see docs for ContainerLogs.
+		_, err := stdcopy.StdCopy(stdoutW, stderrW, rc)
+		_ = stdoutW.CloseWithError(err)
+		_ = stderrW.CloseWithError(err)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		// Reading a followed stream does not return once ctx is done,
+		// so close the stream explicitly to unblock the readers.
+		select {
+		case <-ctx.Done():
+			_ = rc.Close()
+		case <-done:
+		}
+	}()
+
+	var wg sync.WaitGroup
+	scan := func(r *io.PipeReader, stream string) {
+		defer wg.Done()
+		// Closing the reader unblocks the demultiplexer if we stop reading early.
+		defer func() { _ = r.Close() }()
+		s := bufio.NewScanner(r)
+		s.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+		for s.Scan() {
+			line := parseTimestampedLogLine(s.Text())
+			line.Stream = stream
+			select {
+			case out <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+	wg.Add(2)
+	go scan(stdoutR, LogStreamStdout)
+	go scan(stderrR, LogStreamStderr)
+
+	go func() {
+		wg.Wait()
+		close(done)
+		_ = rc.Close()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+// parseTimestampedLogLine strips the RFC3339 timestamp docker prefixes when Timestamps is set,
+// and parses the remainder with ParseLogLine.
+func parseTimestampedLogLine(s string) LogLine {
+	if ts, rest, ok := strings.Cut(s, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			line := ParseLogLine(rest)
+			line.Time = t
+			return line
+		}
+	}
+	return ParseLogLine(s)
+}
+
+var (
+	ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	logTokenRe = regexp.MustCompile(`\S+`)
+	// logFieldRe matches the key=value fields of console log lines. Values may be double quoted.
+	logFieldRe = regexp.MustCompile(`(?:^|\s)([\w.\-/]+)=("(?:[^"\\]|\\.)*"|\S*)`)
+)
+
+// textLevels maps the abbreviated levels of console loggers to full level names.
+var textLevels = map[string]string{
+	"TRC": "trace", "DBG": "debug", "INF": "info", "WRN": "warn", "ERR": "error", "FTL": "fatal", "PNC": "panic",
+	"TRACE": "trace", "DEBUG": "debug", "INFO": "info", "WARN": "warn", "WARNING": "warn", "ERROR": "error", "FATAL": "fatal", "PANIC": "panic",
+}
+
+// ParseLogLine parses a single line of log output, which may be JSON or plain text.
+func ParseLogLine(raw string) LogLine {
+	line := LogLine{Raw: raw}
+
+	trimmed := strings.TrimSpace(raw)
+	if strings.HasPrefix(trimmed, "{") {
+		fields := make(map[string]any)
+		if err := json.Unmarshal([]byte(trimmed), &fields); err == nil {
+			line.Fields = fields
+			line.Level = strings.ToLower(firstString(fields, "level", "lvl", "severity"))
+			line.Message = firstString(fields, "message", "msg", "_msg")
+			return line
+		}
+	}
+
+	clean := ansiEscape.ReplaceAllString(trimmed, "")
+	line.Message = clean
+
+	// Console loggers write "<time> <LEVEL> <message> key=value ...".
+	// The message is the whole remainder after the level, from which key=value fields are parsed.
+	for _, loc := range logTokenRe.FindAllStringIndex(clean, 3) {
+		lvl, ok := textLevels[strings.Trim(clean[loc[0]:loc[1]], "[]:")]
+		if !ok {
+			continue
+		}
+		line.Level = lvl
+		line.Message = strings.TrimSpace(clean[loc[1]:])
+
+		for _, m := range logFieldRe.FindAllStringSubmatch(line.Message, -1) {
+			v := m[2]
+			if uq, err := strconv.Unquote(v); err == nil {
+				v = uq
+			}
+			if line.Fields == nil {
+				line.Fields = make(map[string]any)
+			}
+			line.Fields[m[1]] = v
+		}
+		break
+	}
+
+	return line
+}
+
+func firstString(fields map[string]any, keys ...string) string {
+	for _, k := range keys {
+		if v, ok := fields[k].(string); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// WaitForLog consumes logs until a line's raw content matches re, and returns that line.
+// An error is returned if ctx is done or logs is closed before a match.
+func WaitForLog(ctx context.Context, logs <-chan LogLine, re *regexp.Regexp) (LogLine, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return LogLine{}, fmt.Errorf("waiting for log matching %q: %w", re, ctx.Err())
+		case line, ok := <-logs:
+			if !ok {
+				return LogLine{}, fmt.Errorf("log stream ended before a line matched %q", re)
+			}
+			if re.MatchString(line.Raw) {
+				return line, nil
+			}
+		}
+	}
+}
+
+// ErrLogMatched is wrapped by the error returned from AssertNoLog.
+var ErrLogMatched = errors.New("unexpected log line")
+
+// AssertNoLog consumes logs until ctx is done or logs is closed,
+// and returns an error wrapping ErrLogMatched for the first line matching re.
+// It returns nil if no line matched.
+//
+// AssertNoLog blocks, so it is typically run in its own goroutine for the duration of a test:
+//
+//	watchCtx, stop := context.WithCancel(ctx)
+//	logs, err := node.StreamLogs(watchCtx)
+//	// handle err
+//	errCh := make(chan error, 1)
+//	go func() { errCh <- dockerutil.AssertNoLog(watchCtx, logs, dockerutil.FatalLogPattern) }()
+//	// ... test body ...
+//	stop()
+//	require.NoError(t, <-errCh)
+//
+// To check the log history once instead, pass a stream opened without LogStreamOptions.Follow.
+func AssertNoLog(ctx context.Context, logs <-chan LogLine, re *regexp.Regexp) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case line, ok := <-logs:
+			if !ok {
+				return nil
+			}
+			if re.MatchString(line.Raw) {
+				return fmt.Errorf("%w matching %q at %s: %s", ErrLogMatched, re, line.Time.Format(time.RFC3339Nano), line.Raw)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package dockerutil
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLine(t *testing.T) {
+	for _, tt := range []struct {
+		Name    string
+		Raw     string
+		Level   string
+		Message string
+		Fields  map[string]any
+	}{
+		{
+			Name:    "zerolog json",
+			Raw:     `{"level":"info","module":"server","height":5,"message":"committed state"}`,
+			Level:   "info",
+			Message: "committed state",
+			Fields:  map[string]any{"level": "info", "module": "server", "height": float64(5), "message": "committed state"},
+		},
+		{
+			Name:    "zap json",
+			Raw:     `{"lvl":"ERROR","ts":"2024-01-01T00:00:00Z","msg":"Failed to relay"}`,
+			Level:   "error",
+			Message: "Failed to relay",
+			Fields:  map[string]any{"lvl": "ERROR", "ts": "2024-01-01T00:00:00Z", "msg": "Failed to relay"},
+		},
+		{
+			Name:    "cosmos console",
+			Raw:     "3:04PM \x1b[32mINF\x1b[0m committed state height=5 module=state",
+			Level:   "info",
+			Message: "committed state height=5 module=state",
+			Fields:  map[string]any{"height": "5", "module": "state"},
+		},
+		{
+			Name:    "quoted fields",
+			Raw:     `2024-01-01T00:00:00Z ERROR failed to relay err="packet timed out" chain=a then retrying`,
+			Level:   "error",
+			Message: `failed to relay err="packet timed out" chain=a then retrying`,
+			Fields:  map[string]any{"err": "packet timed out", "chain": "a"},
+		},
+		{
+			Name:    "unstructured",
+			Raw:     "panic: something went wrong",
+			Message: "panic: something went wrong",
+		},
+	} {
+		line := ParseLogLine(tt.Raw)
+		require.Equal(t, tt.Raw, line.Raw, tt.Name)
+		require.Equal(t, tt.Level, line.Level, tt.Name)
+		require.Equal(t, tt.Message, line.Message, tt.Name)
+		require.Equal(t, tt.Fields, line.Fields, tt.Name)
+	}
+}
+
+func TestParseTimestampedLogLine(t *testing.T) {
+	line := parseTimestampedLogLine("2024-03-01T10:00:00.123456789Z 3:04PM ERR CONSENSUS FAILURE!!! err=boom")
+	require.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 123456789, time.UTC), line.Time)
+	require.Equal(t, "error", line.Level)
+	require.Equal(t, "3:04PM ERR CONSENSUS FAILURE!!! err=boom", line.Raw)
+}
+
+func logChan(raws ...string) <-chan LogLine {
+	ch := make(chan LogLine, len(raws))
+	for _, raw := range raws {
+		ch <- ParseLogLine(raw)
+	}
+	close(ch)
+	return ch
+}
+
+func TestWaitForLog(t *testing.T) {
+	ctx := context.Background()
+	re := regexp.MustCompile(`UPGRADE "v2" NEEDED`)
+
+	line, err := WaitForLog(ctx, logChan("INF starting", `ERR UPGRADE "v2" NEEDED at height: 20`, "INF after"), re)
+	require.NoError(t, err)
+	require.Equal(t, "error", line.Level)
+
+	_, err = WaitForLog(ctx, logChan("INF starting"), re)
+	require.Error(t, err)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = WaitForLog(cctx, make(chan LogLine), re)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAssertNoLog(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, AssertNoLog(ctx, logChan("INF committed state", "INF indexed block"), FatalLogPattern))
+
+	err := AssertNoLog(ctx, logChan("INF committed state", "CONSENSUS FAILURE!!!"), FatalLogPattern)
+	require.True(t, errors.Is(err, ErrLogMatched))
+	require.Contains(t, err.Error(), "CONSENSUS FAILURE!!!")
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, AssertNoLog(cctx, make(chan LogLine), FatalLogPattern))
+}
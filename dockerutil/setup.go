@@ -108,6 +108,12 @@ func DockerCleanup(t DockerSetupTestingT, cli *client.Client) func() {
 			return
 		}
 
+		if shouldCollectArtifacts(t) {
+			CollectArtifacts(ctx, t, cli, ArtifactsDir)
+		} else {
+			discardSavedArtifacts(t)
+		}
+
 		for _, c := range cs {
 			if (t.Failed() && showContainerLogs == "") || showContainerLogs == "always" {
 				logTail := "50"
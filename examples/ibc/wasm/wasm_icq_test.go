@@ -54,15 +54,7 @@ func TestInterchainQueriesWASM(t *testing.T) {
 		UidGid:     dockerutil.GetHeighlinerUserString(),
 	}
 
-	genesisAllowICQ := map[string]interface{}{
-		"interchainquery": map[string]interface{}{
-			"host_port": "icqhost",
-			"params": map[string]interface{}{
-				"host_enabled":  true,
-				"allow_queries": []interface{}{"/cosmos.bank.v1beta1.Query/AllBalances"},
-			},
-		},
-	}
+	genesisAllowICQ := cosmosChain.ICQHostGenesis("/cosmos.bank.v1beta1.Query/AllBalances")
 
 	minVal := 1
 	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
@@ -81,7 +73,7 @@ func TestInterchainQueriesWASM(t *testing.T) {
 				TrustingPeriod: "300h",
 				GasAdjustment:  1.1,
 				EncodingConfig: wasm.WasmEncoding(),
-				ModifyGenesis:  modifyGenesisAtPath(genesisAllowICQ, "app_state"),
+				ModifyGenesis:  cosmosChain.ModifyGenesis(genesisAllowICQ),
 			}},
 		{
 			ChainName:     "receiver",
@@ -98,7 +90,7 @@ func TestInterchainQueriesWASM(t *testing.T) {
 				TrustingPeriod: "300h",
 				GasAdjustment:  1.1,
 				EncodingConfig: wasm.WasmEncoding(),
-				ModifyGenesis:  modifyGenesisAtPath(genesisAllowICQ, "app_state"),
+				ModifyGenesis:  cosmosChain.ModifyGenesis(genesisAllowICQ),
 			}},
 	})
 
@@ -357,40 +349,3 @@ type statusResults struct {
 		Height string `json:"latest_block_height"`
 	} `json:"SyncInfo"`
 }
-
-func modifyGenesisAtPath(insertedBlock map[string]interface{}, key string) func(ibc.ChainConfig, []byte) ([]byte, error) {
-	return func(chainConfig ibc.ChainConfig, genbz []byte) ([]byte, error) {
-		g := make(map[string]interface{})
-		if err := json.Unmarshal(genbz, &g); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal genesis file: %w", err)
-		}
-
-		//Get the section of the genesis file under the given key (e.g. "app_state")
-		genesisBlockI, ok := g[key]
-		if !ok {
-			return nil, fmt.Errorf("genesis json does not have top level key: %s", key)
-		}
-
-		blockBytes, mErr := json.Marshal(genesisBlockI)
-		if mErr != nil {
-			return nil, fmt.Errorf("genesis json marshal error for block with key: %s", key)
-		}
-
-		genesisBlock := make(map[string]interface{})
-		mErr = json.Unmarshal(blockBytes, &genesisBlock)
-		if mErr != nil {
-			return nil, fmt.Errorf("genesis json unmarshal error for block with key: %s", key)
-		}
-
-		for k, v := range insertedBlock {
-			genesisBlock[k] = v
-		}
-
-		g[key] = genesisBlock
-		out, err := json.Marshal(g)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal genesis bytes to json: %w", err)
-		}
-		return out, nil
-	}
-}
@@ -0,0 +1,85 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// RegisterCounterpartyPayees registers, on both ends of every fee-enabled channel of l,
+// l.Relayer's wallet on the counterparty chain as the counterparty payee of its wallet on that end,
+// so that ICS-29 recv fees are paid to the relayer on the chain the packet was sent from.
+// The relayer must have the relayer.FeeMiddleware capability.
+func (l *Link) RegisterCounterpartyPayees(ctx context.Context, rep ibc.RelayerExecReporter) error {
+	for _, ch := range l.Channels {
+		if !strings.Contains(ch.Version, feetypes.Version) {
+			continue
+		}
+		for _, end := range []LinkEnd{l.Chain1, l.Chain2} {
+			c := ch
+			if end.Chain == l.Chain2.Chain {
+				c = flipChannel(ch, l.Chain2.ConnectionID)
+			}
+			counterparty, _ := l.Counterparty(end.Chain)
+
+			chainID, counterpartyID := end.Chain.Config().ChainID, counterparty.Chain.Config().ChainID
+			relayerWallet, ok := l.Relayer.GetWallet(chainID)
+			if !ok {
+				return fmt.Errorf("relayer of path %s has no wallet on %s", l.Path, chainID)
+			}
+			payeeWallet, ok := l.Relayer.GetWallet(counterpartyID)
+			if !ok {
+				return fmt.Errorf("relayer of path %s has no wallet on %s", l.Path, counterpartyID)
+			}
+
+			if err := l.Relayer.RegisterCounterpartyPayee(
+				ctx, rep, chainID, c.ChannelID, c.PortID, relayerWallet.FormattedAddress(), payeeWallet.FormattedAddress(),
+			); err != nil {
+				return fmt.Errorf("failed to register counterparty payee for %s/%s on %s: %w", c.PortID, c.ChannelID, chainID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// FeeBalances returns the balances of address on chain in every denom of fee,
+// to be passed to AssertFeePayout once the incentivized packets are relayed.
+func FeeBalances(ctx context.Context, chain ibc.Chain, address string, fee feetypes.Fee) (sdk.Coins, error) {
+	var balances sdk.Coins
+	for _, coin := range fee.Total() {
+		amount, err := chain.GetBalance(ctx, address, coin.Denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s balance of %s on %s: %w", coin.Denom, address, chain.Config().ChainID, err)
+		}
+		balances = balances.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return balances, nil
+}
+
+// AssertFeePayout checks that address received exactly expected on chain since its balances were before,
+// as returned by FeeBalances.
+//
+// Relayer fees are paid out on the chain the incentivized packet was sent from:
+// the recv fee to the forward relayer's counterparty payee, the ack fee to the reverse relayer,
+// and the timeout fee is refunded to the payer once the packet is acknowledged.
+// Since relayers also pay gas, address should be a payee which does not sign transactions,
+// or expected must account for the gas spent.
+func AssertFeePayout(ctx context.Context, chain ibc.Chain, address string, before, expected sdk.Coins) error {
+	for _, coin := range before.Add(expected...) {
+		amount, err := chain.GetBalance(ctx, address, coin.Denom)
+		if err != nil {
+			return fmt.Errorf("failed to query %s balance of %s on %s: %w", coin.Denom, address, chain.Config().ChainID, err)
+		}
+		if received := amount.Sub(before.AmountOf(coin.Denom)); !received.Equal(expected.AmountOf(coin.Denom)) {
+			return fmt.Errorf(
+				"expected %s to receive %s%s in fees on %s, but received %s%s",
+				address, expected.AmountOf(coin.Denom), coin.Denom, chain.Config().ChainID, received, coin.Denom,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package ibc
+
+import "fmt"
+
+// ChannelUpgradeStep is a step of the ibc-go channel upgrade handshake which a relayer submits.
+//
+// The handshake starts with MsgChannelUpgradeInit on one chain, which is restricted to the chain's authority
+// and so is submitted through governance rather than by a relayer.
+type ChannelUpgradeStep int
+
+const (
+	// ChannelUpgradeTry is submitted to the counterparty of the chain which initialized the upgrade.
+	ChannelUpgradeTry ChannelUpgradeStep = iota + 1
+	// ChannelUpgradeAck is submitted to the chain which initialized the upgrade.
+	ChannelUpgradeAck
+	// ChannelUpgradeConfirm is submitted to the counterparty of the chain which initialized the upgrade.
+	ChannelUpgradeConfirm
+	// ChannelUpgradeOpen is submitted to the chain which initialized the upgrade.
+	ChannelUpgradeOpen
+)
+
+// ChannelUpgradeSteps lists the relayer steps of the channel upgrade handshake in the order they are submitted.
+var ChannelUpgradeSteps = []ChannelUpgradeStep{ChannelUpgradeTry, ChannelUpgradeAck, ChannelUpgradeConfirm, ChannelUpgradeOpen}
+
+// String returns the lowercase name of the step, e.g. "try".
+func (s ChannelUpgradeStep) String() string {
+	switch s {
+	case ChannelUpgradeTry:
+		return "try"
+	case ChannelUpgradeAck:
+		return "ack"
+	case ChannelUpgradeConfirm:
+		return "confirm"
+	case ChannelUpgradeOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("ChannelUpgradeStep(%d)", int(s))
+	}
+}
+
+// OnInitiator reports whether the step is submitted to the chain which initialized the upgrade,
+// rather than to its counterparty.
+func (s ChannelUpgradeStep) OnInitiator() bool {
+	return s == ChannelUpgradeAck || s == ChannelUpgradeOpen
+}
+
+// ChannelUpgradeOptions identifies the channel end a ChannelUpgradeStep is submitted to.
+type ChannelUpgradeOptions struct {
+	// ChainID is the chain the step is submitted to.
+	ChainID string
+
+	// Channel is the channel being upgraded, as seen from ChainID.
+	Channel ChannelOutput
+}
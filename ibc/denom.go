@@ -0,0 +1,38 @@
+package ibc
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// IBCDenom returns the denom held in bank balances for tokens with the given full denom path,
+// e.g. "ibc/27394FB0..." for "transfer/channel-0/uatom", or the base denom itself if the path has no trace.
+func IBCDenom(denomPath string) string {
+	return transfertypes.ParseDenomTrace(denomPath).IBCDenom()
+}
+
+// VoucherDenomPath returns the full denom path of tokens with denomPath
+// after transferring them over each of the given channels in turn.
+// Each channel must be seen from the chain sending over it,
+// so its Counterparty is the end on the receiving chain.
+//
+// Tokens sent back over the channel they arrived on are unwound, as ICS-20 does,
+// so a round trip returns the original denomPath.
+func VoucherDenomPath(denomPath string, channels ...ChannelOutput) string {
+	for _, ch := range channels {
+		if transfertypes.ReceiverChainIsSource(ch.PortID, ch.ChannelID, denomPath) {
+			denomPath = strings.TrimPrefix(denomPath, transfertypes.GetDenomPrefix(ch.PortID, ch.ChannelID))
+			continue
+		}
+		denomPath = transfertypes.GetPrefixedDenom(ch.Counterparty.PortID, ch.Counterparty.ChannelID, denomPath)
+	}
+	return denomPath
+}
+
+// VoucherDenom returns the denom held in bank balances on the receiving chain
+// after transferring tokens with denomPath over each of the given channels in turn.
+// See VoucherDenomPath.
+func VoucherDenom(denomPath string, channels ...ChannelOutput) string {
+	return IBCDenom(VoucherDenomPath(denomPath, channels...))
+}
@@ -0,0 +1,39 @@
+package ibc
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoucherDenom(t *testing.T) {
+	// A -> B over A's channel-0, whose counterparty on B is channel-3.
+	ab := ChannelOutput{
+		PortID: "transfer", ChannelID: "channel-0",
+		Counterparty: ChannelCounterparty{PortID: "transfer", ChannelID: "channel-3"},
+	}
+	// B -> C over B's channel-4, whose counterparty on C is channel-9.
+	bc := ChannelOutput{
+		PortID: "transfer", ChannelID: "channel-4",
+		Counterparty: ChannelCounterparty{PortID: "transfer", ChannelID: "channel-9"},
+	}
+	// B -> A over B's channel-3.
+	ba := ChannelOutput{
+		PortID: "transfer", ChannelID: "channel-3",
+		Counterparty: ChannelCounterparty{PortID: "transfer", ChannelID: "channel-0"},
+	}
+
+	require.Equal(t, "uatom", VoucherDenomPath("uatom"))
+	require.Equal(t, "uatom", IBCDenom("uatom"))
+
+	require.Equal(t, "transfer/channel-3/uatom", VoucherDenomPath("uatom", ab))
+	require.Equal(t, "transfer/channel-9/transfer/channel-3/uatom", VoucherDenomPath("uatom", ab, bc))
+
+	// Returning to the source unwinds the trace.
+	require.Equal(t, "uatom", VoucherDenomPath("uatom", ab, ba))
+
+	expected := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-3", "uatom")).IBCDenom()
+	require.Equal(t, expected, VoucherDenom("uatom", ab))
+	require.Equal(t, expected, IBCDenom("transfer/channel-3/uatom"))
+}
@@ -0,0 +1,27 @@
+package ibc
+
+import (
+	"encoding/json"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+// FeeEnabledVersion returns the channel version negotiated by the ICS-29 fee middleware
+// wrapping an application with appVersion, e.g. for "ics20-1":
+//
+//	{"fee_version":"ics29-1","app_version":"ics20-1"}
+func FeeEnabledVersion(appVersion string) string {
+	bz, err := json.Marshal(feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: appVersion})
+	if err != nil {
+		// Marshalling two strings cannot fail.
+		panic(err)
+	}
+	return string(bz)
+}
+
+// DefaultFeeChannelOpts returns the default settings for creating a fee-enabled ics20 fungible token transfer channel.
+func DefaultFeeChannelOpts() CreateChannelOptions {
+	opts := DefaultChannelOpts()
+	opts.Version = FeeEnabledVersion(opts.Version)
+	return opts
+}
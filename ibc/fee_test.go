@@ -0,0 +1,13 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeEnabledVersion(t *testing.T) {
+	require.Equal(t, `{"fee_version":"ics29-1","app_version":"ics20-1"}`, FeeEnabledVersion("ics20-1"))
+	require.Equal(t, FeeEnabledVersion("ics20-1"), DefaultFeeChannelOpts().Version)
+	require.NoError(t, DefaultFeeChannelOpts().Validate())
+}
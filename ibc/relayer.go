@@ -89,6 +89,16 @@ type Relayer interface {
 	// CreateChannel creates a channel on the given path with the provided options.
 	CreateChannel(ctx context.Context, rep RelayerExecReporter, pathName string, opts CreateChannelOptions) error
 
+	// RegisterCounterpartyPayee registers counterpartyPayee, an address on the counterparty chain,
+	// to receive the ICS-29 fees earned by relayerAddr for relaying packets on the given channel of chainID.
+	// Only relayers with the relayer.FeeMiddleware capability support this.
+	RegisterCounterpartyPayee(ctx context.Context, rep RelayerExecReporter, chainID, channelID, portID, relayerAddr, counterpartyPayee string) error
+
+	// UpgradeChannel submits a single step of the channel upgrade handshake on the given path.
+	// The upgrade must have been initialized on the counterparty chain, typically through governance.
+	// Only relayers with the relayer.ChannelUpgrade capability support this.
+	UpgradeChannel(ctx context.Context, rep RelayerExecReporter, pathName string, step ChannelUpgradeStep, opts ChannelUpgradeOptions) error
+
 	// UseDockerNetwork reports whether the relayer is run in the same docker network as the other chains.
 	//
 	// If false, the relayer will connect to the localhost-exposed ports instead of the docker hosts.
@@ -342,3 +352,38 @@ type NopRelayerExecReporter struct{}
 
 func (NopRelayerExecReporter) TrackRelayerExec(string, []string, string, string, int, time.Time, time.Time, error) {
 }
+
+// ChainExecReporter is the interface of a narrow type returned by testreporter.RelayerExecReporter,
+// used by chain implementations to report commands executed against their nodes.
+// Like RelayerExecReporter, this avoids a direct dependency on the testreporter package.
+type ChainExecReporter interface {
+	TrackChainExec(
+		// The ID of the chain the command was executed against.
+		chainID string,
+
+		// The name of the node the command targeted.
+		nodeName string,
+
+		// The full command line, including the chain binary.
+		command []string,
+
+		// The standard output and standard error produced by the command.
+		stdout, stderr string,
+
+		// The exit code of executing the command.
+		exitCode int,
+
+		// When the command started and finished.
+		startedAt, finishedAt time.Time,
+
+		// Any error that occurred during execution,
+		// including a non-zero exit code.
+		err error,
+	)
+}
+
+// NopChainExecReporter is a no-op ChainExecReporter.
+type NopChainExecReporter struct{}
+
+func (NopChainExecReporter) TrackChainExec(string, string, []string, string, string, int, time.Time, time.Time, error) {
+}
@@ -77,6 +77,10 @@ type ChainConfig struct {
 	AdditionalStartArgs []string
 	// Environment variables for chain nodes
 	Env []string
+	// EnableTelemetry enables the CometBFT and cosmos-sdk Prometheus metrics of chain nodes,
+	// and exposes the CometBFT metrics port to the host.
+	// Access the addresses with ChainNode.MetricsAddress and ChainNode.AppMetricsAddress.
+	EnableTelemetry bool `yaml:"enable-telemetry"`
 }
 
 func (c ChainConfig) Clone() ChainConfig {
@@ -216,6 +220,10 @@ func (c ChainConfig) MergeChainSpecConfig(other ChainConfig) ChainConfig {
 		c.Env = append(c.Env, other.Env...)
 	}
 
+	if other.EnableTelemetry {
+		c.EnableTelemetry = true
+	}
+
 	if len(other.ExposeAdditionalPorts) > 0 {
 		c.ExposeAdditionalPorts = append(c.ExposeAdditionalPorts, other.ExposeAdditionalPorts...)
 	}
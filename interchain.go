@@ -3,6 +3,7 @@ package interchaintest
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"cosmossdk.io/math"
 	"github.com/docker/docker/client"
@@ -27,6 +28,10 @@ type Interchain struct {
 	// Key: relayer and path name; Value: the two chains being linked.
 	links map[relayerPath]interchainLink
 
+	// Key: relayer and path name; Value: the identifiers created for the link during Build.
+	linked   map[relayerPath]*Link
+	linkedMu sync.Mutex
+
 	// Key: relayer and path name; Value: the provider and consumer chain link.
 	providerConsumerLinks map[relayerPath]providerConsumerLink
 
@@ -55,6 +60,9 @@ type interchainLink struct {
 	// If a zero value initialization is used, e.g. CreateChannelOptions{},
 	// then the default values will be used via ibc.DefaultChannelOpts.
 	createChannelOpts ibc.CreateChannelOptions
+
+	// If set, these channels are created instead of the one described by createChannelOpts.
+	channels []ibc.CreateChannelOptions
 }
 
 type providerConsumerLink struct {
@@ -83,6 +91,7 @@ func NewInterchain() *Interchain {
 		relayers: make(map[ibc.Relayer]string),
 
 		links:                 make(map[relayerPath]interchainLink),
+		linked:                make(map[relayerPath]*Link),
 		providerConsumerLinks: make(map[relayerPath]providerConsumerLink),
 	}
 }
@@ -209,6 +218,13 @@ type InterchainLink struct {
 	// If a zero value initialization is used, e.g. CreateChannelOptions{},
 	// then the default values will be used via ibc.DefaultChannelOpts.
 	CreateChannelOpts ibc.CreateChannelOptions
+
+	// If set, every channel is created on the path's connection, in order,
+	// instead of the single channel described by CreateChannelOpts,
+	// e.g. a transfer channel alongside ICA or wasm channels.
+	// Zero value entries use the default values via ibc.DefaultChannelOpts.
+	// The resulting identifiers are available through (*Interchain).Link after Build.
+	Channels []ibc.CreateChannelOptions
 }
 
 type ProviderConsumerLink struct {
@@ -250,6 +266,10 @@ func (ic *Interchain) AddLink(link InterchainLink) *Interchain {
 		panic(fmt.Errorf("chains must be different (both were %v)", link.Chain1))
 	}
 
+	if len(link.Channels) > 0 && link.CreateChannelOpts != (ibc.CreateChannelOptions{}) {
+		panic(fmt.Errorf("link for path %q cannot set both CreateChannelOpts and Channels", link.Path))
+	}
+
 	key := relayerPath{
 		Relayer: link.Relayer,
 		Path:    link.Path,
@@ -263,6 +283,7 @@ func (ic *Interchain) AddLink(link InterchainLink) *Interchain {
 		chains:            [2]ibc.Chain{link.Chain1, link.Chain2},
 		createChannelOpts: link.CreateChannelOpts,
 		createClientOpts:  link.CreateClientOpts,
+		channels:          append([]ibc.CreateChannelOptions(nil), link.Channels...),
 	}
 	return ic
 }
@@ -303,6 +324,15 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 	}
 	ic.cs = newChainSet(ic.log, chains)
 
+	// Track commands and container events of chains alongside relayer commands.
+	if rep != nil {
+		for chain := range ic.chains {
+			if c, ok := chain.(interface{ WithExecReporter(ibc.ChainExecReporter) }); ok {
+				c.WithExecReporter(rep)
+			}
+		}
+	}
+
 	// Consumer chains need to have the same number of validators as their provider.
 	// Consumer also needs reference to its provider chain.
 	for _, providerConsumerLink := range ic.providerConsumerLinks {
@@ -473,6 +503,9 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 				)
 			}
 
+			// Connections are only queried to build the Link, so failures are not fatal to linking.
+			existing, existingErr := rp.Relayer.GetConnections(ctx, rep, c.Config().ChainID)
+
 			// Connection handshake
 			if err := rp.Relayer.CreateConnections(ctx, rep, rp.Path); err != nil {
 				return fmt.Errorf(
@@ -494,45 +527,25 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 				)
 			}
 
+			if existingErr == nil {
+				ic.recordLink(ctx, rep, rp, c, p, existing)
+			}
+
 			return nil
 		})
 	}
 
 	// Now link the paths in parallel
 	// Creates clients, connections, and channels for each link/path.
-	for rp, link := range ic.links {
-		rp := rp
-		link := link
-		c0 := link.chains[0]
-		c1 := link.chains[1]
+	// Paths between the same pair of chains are linked sequentially,
+	// so that the identifiers created for each path can be told apart.
+	for _, group := range ic.linksByChainPair() {
+		group := group
 		eg.Go(func() error {
-			// If the user specifies a zero value CreateClientOptions struct then we fall back to the default
-			// client options.
-			if link.createClientOpts == (ibc.CreateClientOptions{}) {
-				link.createClientOpts = ibc.DefaultClientOpts()
-			}
-
-			// Check that the client creation options are valid and fully specified.
-			if err := link.createClientOpts.Validate(); err != nil {
-				return err
-			}
-
-			// If the user specifies a zero value CreateChannelOptions struct then we fall back to the default
-			// channel options for an ics20 fungible token transfer channel.
-			if link.createChannelOpts == (ibc.CreateChannelOptions{}) {
-				link.createChannelOpts = ibc.DefaultChannelOpts()
-			}
-
-			// Check that the channel creation options are valid and fully specified.
-			if err := link.createChannelOpts.Validate(); err != nil {
-				return err
-			}
-
-			if err := rp.Relayer.LinkPath(ctx, rep, rp.Path, link.createChannelOpts, link.createClientOpts); err != nil {
-				return fmt.Errorf(
-					"failed to link path %s on relayer %s between chains %s and %s: %w",
-					rp.Path, rp.Relayer, ic.chains[c0], ic.chains[c1], err,
-				)
+			for _, rp := range group {
+				if err := ic.linkPath(ctx, rep, rp, ic.links[rp]); err != nil {
+					return err
+				}
 			}
 			return nil
 		})
@@ -541,6 +554,29 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 	return eg.Wait()
 }
 
+// linksByChainPair groups the keys of ic.links by their unordered pair of chains.
+func (ic *Interchain) linksByChainPair() [][]relayerPath {
+	type chainPair [2]string
+	groups := make(map[chainPair][]relayerPath)
+	var order []chainPair
+	for rp, link := range ic.links {
+		pair := chainPair{ic.chains[link.chains[0]], ic.chains[link.chains[1]]}
+		if pair[1] < pair[0] {
+			pair[0], pair[1] = pair[1], pair[0]
+		}
+		if _, ok := groups[pair]; !ok {
+			order = append(order, pair)
+		}
+		groups[pair] = append(groups[pair], rp)
+	}
+
+	out := make([][]relayerPath, 0, len(order))
+	for _, pair := range order {
+		out = append(out, groups[pair])
+	}
+	return out
+}
+
 // WithLog sets the logger on the interchain object.
 // Usually the default nop logger is fine, but sometimes it can be helpful
 // to see more verbose logs, typically by passing zaptest.NewLogger(t).
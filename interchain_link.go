@@ -0,0 +1,287 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"go.uber.org/zap"
+)
+
+// Link describes the IBC clients, connection, and channels that Build created for an InterchainLink.
+// Retrieve it with (*Interchain).Link after Build returns.
+type Link struct {
+	Relayer ibc.Relayer
+	Path    string
+
+	// The two ends of the path's connection.
+	Chain1, Chain2 LinkEnd
+
+	// Channels created on the path's connection, in creation order, as seen from Chain1.
+	// The Counterparty of each channel is its end on Chain2.
+	// Use ChannelOn to view a channel from either chain.
+	Channels []ibc.ChannelOutput
+}
+
+// LinkEnd holds the identifiers of one side of a Link.
+type LinkEnd struct {
+	Chain ibc.Chain
+
+	// The client on Chain tracking the counterparty chain.
+	ClientID string
+
+	// The connection on Chain belonging to the path.
+	ConnectionID string
+}
+
+// End returns the end of l on chain, and false if chain is not part of l.
+func (l *Link) End(chain ibc.Chain) (LinkEnd, bool) {
+	switch chain {
+	case l.Chain1.Chain:
+		return l.Chain1, true
+	case l.Chain2.Chain:
+		return l.Chain2, true
+	default:
+		return LinkEnd{}, false
+	}
+}
+
+// Counterparty returns the end of l opposite to chain, and false if chain is not part of l.
+func (l *Link) Counterparty(chain ibc.Chain) (LinkEnd, bool) {
+	switch chain {
+	case l.Chain1.Chain:
+		return l.Chain2, true
+	case l.Chain2.Chain:
+		return l.Chain1, true
+	default:
+		return LinkEnd{}, false
+	}
+}
+
+// ChannelOn returns the first channel of l bound to portID on chain, as seen from chain,
+// so that its PortID and ChannelID are chain's identifiers and its Counterparty
+// holds the identifiers on the other chain.
+// It returns false if chain is not part of l or no such channel exists.
+func (l *Link) ChannelOn(chain ibc.Chain, portID string) (ibc.ChannelOutput, bool) {
+	for _, ch := range l.Channels {
+		switch chain {
+		case l.Chain1.Chain:
+			if ch.PortID == portID {
+				return ch, true
+			}
+		case l.Chain2.Chain:
+			if ch.Counterparty.PortID == portID {
+				return flipChannel(ch, l.Chain2.ConnectionID), true
+			}
+		default:
+			return ibc.ChannelOutput{}, false
+		}
+	}
+	return ibc.ChannelOutput{}, false
+}
+
+// flipChannel returns ch as seen from its counterparty, whose connection is connectionID.
+func flipChannel(ch ibc.ChannelOutput, connectionID string) ibc.ChannelOutput {
+	return ibc.ChannelOutput{
+		State:          ch.State,
+		Ordering:       ch.Ordering,
+		Version:        ch.Version,
+		PortID:         ch.Counterparty.PortID,
+		ChannelID:      ch.Counterparty.ChannelID,
+		ConnectionHops: []string{connectionID},
+		Counterparty: ibc.ChannelCounterparty{
+			PortID:    ch.PortID,
+			ChannelID: ch.ChannelID,
+		},
+	}
+}
+
+// Link returns the IBC identifiers created during Build for the link added with the given relayer and path name,
+// by either AddLink or AddProviderConsumerLink. For the latter, Chain1 is the consumer chain.
+// An error is returned if Build has not linked the path,
+// e.g. because it was not called, InterchainBuildOptions.SkipPathCreation was set,
+// or the relayer's connections could not be queried.
+func (ic *Interchain) Link(r ibc.Relayer, pathName string) (*Link, error) {
+	key := relayerPath{Relayer: r, Path: pathName}
+	_, isLink := ic.links[key]
+	_, isProviderConsumerLink := ic.providerConsumerLinks[key]
+	if !isLink && !isProviderConsumerLink {
+		return nil, fmt.Errorf("relayer %v has no path named %q", r, pathName)
+	}
+
+	ic.linkedMu.Lock()
+	defer ic.linkedMu.Unlock()
+
+	l, ok := ic.linked[key]
+	if !ok {
+		return nil, fmt.Errorf("path %q on relayer %v was not linked during Build", pathName, r)
+	}
+	return l, nil
+}
+
+// linkPath creates the clients, connection, and channels of link on the relayer path,
+// and records the resulting identifiers so they can be retrieved with Link.
+// Links between the same pair of chains must not be linked concurrently,
+// as the new connection is identified by comparison with those existing before linking.
+func (ic *Interchain) linkPath(ctx context.Context, rep ibc.RelayerExecReporter, rp relayerPath, link interchainLink) error {
+	c0, c1 := link.chains[0], link.chains[1]
+
+	// If the user specifies a zero value CreateClientOptions struct then we fall back to the default
+	// client options.
+	if link.createClientOpts == (ibc.CreateClientOptions{}) {
+		link.createClientOpts = ibc.DefaultClientOpts()
+	}
+
+	// Check that the client creation options are valid and fully specified.
+	if err := link.createClientOpts.Validate(); err != nil {
+		return err
+	}
+
+	channels := append([]ibc.CreateChannelOptions(nil), link.channels...)
+	if len(channels) == 0 {
+		channels = []ibc.CreateChannelOptions{link.createChannelOpts}
+	}
+	for i := range channels {
+		// If the user specifies a zero value CreateChannelOptions struct then we fall back to the default
+		// channel options for an ics20 fungible token transfer channel.
+		if channels[i] == (ibc.CreateChannelOptions{}) {
+			channels[i] = ibc.DefaultChannelOpts()
+		}
+
+		// Check that the channel creation options are valid and fully specified.
+		if err := channels[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Connections are only queried to build the Link, so failures are not fatal to linking.
+	existing, existingErr := rp.Relayer.GetConnections(ctx, rep, c0.Config().ChainID)
+	if existingErr != nil {
+		ic.log.Warn(
+			"Failed to query connections before linking path",
+			zap.String("path", rp.Path),
+			zap.String("chain", ic.chains[c0]),
+			zap.Error(existingErr),
+		)
+	}
+
+	if err := rp.Relayer.LinkPath(ctx, rep, rp.Path, channels[0], link.createClientOpts); err != nil {
+		return fmt.Errorf(
+			"failed to link path %s on relayer %s between chains %s and %s: %w",
+			rp.Path, rp.Relayer, ic.chains[c0], ic.chains[c1], err,
+		)
+	}
+
+	// Additional channels reuse the connection created by LinkPath.
+	for _, opts := range channels[1:] {
+		if err := rp.Relayer.CreateChannel(ctx, rep, rp.Path, opts); err != nil {
+			return fmt.Errorf(
+				"failed to create channel on port %s on path %s on relayer %s between chains %s and %s: %w",
+				opts.SourcePortName, rp.Path, rp.Relayer, ic.chains[c0], ic.chains[c1], err,
+			)
+		}
+	}
+
+	// Without the prior connections, the path's connection cannot be told apart from others.
+	if existingErr == nil {
+		ic.recordLink(ctx, rep, rp, c0, c1, existing)
+	}
+	return nil
+}
+
+// recordLink queries the identifiers of the linked path between c0 and c1,
+// given the connections of c0 existing before the path was linked,
+// and records them so they can be retrieved with Link.
+// Failures are logged rather than returned, as the path itself is usable.
+func (ic *Interchain) recordLink(ctx context.Context, rep ibc.RelayerExecReporter, rp relayerPath, c0, c1 ibc.Chain, existing ibc.ConnectionOutputs) {
+	l, err := queryLink(ctx, rep, rp, c0, c1, existing)
+	if err != nil {
+		ic.log.Warn(
+			"Failed to query identifiers of linked path",
+			zap.String("path", rp.Path),
+			zap.Error(err),
+		)
+		return
+	}
+
+	ic.linkedMu.Lock()
+	ic.linked[rp] = l
+	ic.linkedMu.Unlock()
+}
+
+// queryLink finds the connection on c0 tracking c1 that is not in existing,
+// and the channels on that connection.
+func queryLink(ctx context.Context, rep ibc.RelayerExecReporter, rp relayerPath, c0, c1 ibc.Chain, existing ibc.ConnectionOutputs) (*Link, error) {
+	chainID0, chainID1 := c0.Config().ChainID, c1.Config().ChainID
+
+	clients, err := rp.Relayer.GetClients(ctx, rep, chainID0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query clients of %s: %w", chainID0, err)
+	}
+	tracking := make(map[string]bool, len(clients))
+	for _, c := range clients {
+		tracking[c.ClientID] = c.ClientState.ChainID == chainID1
+	}
+
+	seen := make(map[string]bool, len(existing))
+	for _, c := range existing {
+		seen[c.ID] = true
+	}
+
+	conns, err := rp.Relayer.GetConnections(ctx, rep, chainID0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query connections of %s: %w", chainID0, err)
+	}
+	var conn *ibc.ConnectionOutput
+	for _, c := range conns {
+		if !seen[c.ID] && tracking[c.ClientID] && c.Counterparty != nil {
+			conn = c
+			break
+		}
+	}
+	if conn == nil {
+		return nil, fmt.Errorf("no new connection on %s tracking %s", chainID0, chainID1)
+	}
+
+	allChannels, err := rp.Relayer.GetChannels(ctx, rep, chainID0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query channels of %s: %w", chainID0, err)
+	}
+	var channels []ibc.ChannelOutput
+	for _, ch := range allChannels {
+		if len(ch.ConnectionHops) > 0 && ch.ConnectionHops[0] == conn.ID {
+			channels = append(channels, ch)
+		}
+	}
+	sort.SliceStable(channels, func(i, j int) bool {
+		return identifierSequence(channels[i].ChannelID) < identifierSequence(channels[j].ChannelID)
+	})
+
+	return &Link{
+		Relayer: rp.Relayer,
+		Path:    rp.Path,
+		Chain1: LinkEnd{
+			Chain:        c0,
+			ClientID:     conn.ClientID,
+			ConnectionID: conn.ID,
+		},
+		Chain2: LinkEnd{
+			Chain:        c1,
+			ClientID:     conn.Counterparty.ClientId,
+			ConnectionID: conn.Counterparty.ConnectionId,
+		},
+		Channels: channels,
+	}, nil
+}
+
+// identifierSequence returns the trailing sequence number of an IBC identifier such as "channel-12".
+func identifierSequence(id string) int {
+	n, err := strconv.Atoi(id[strings.LastIndex(id, "-")+1:])
+	if err != nil {
+		return -1
+	}
+	return n
+}
@@ -440,6 +440,90 @@ func TestInterchain_ConflictRejection(t *testing.T) {
 			_ = interchaintest.NewInterchain().AddRelayer(&r1, "r").AddRelayer(&r2, "r")
 		})
 	})
+
+	t.Run("channels and channel options", func(t *testing.T) {
+		cf := interchaintest.NewBuiltinChainFactory(zap.NewNop(), []*interchaintest.ChainSpec{
+			{Name: "gaia", ChainName: "g1", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-0"}},
+			{Name: "gaia", ChainName: "g2", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-1"}},
+		})
+
+		chains, err := cf.Chains(t.Name())
+		require.NoError(t, err)
+
+		var r rly.CosmosRelayer
+		require.PanicsWithError(t, `link for path "p" cannot set both CreateChannelOpts and Channels`, func() {
+			_ = interchaintest.NewInterchain().
+				AddChain(chains[0]).
+				AddChain(chains[1]).
+				AddRelayer(&r, "r").
+				AddLink(interchaintest.InterchainLink{
+					Chain1:            chains[0],
+					Chain2:            chains[1],
+					Relayer:           &r,
+					Path:              "p",
+					CreateChannelOpts: ibc.DefaultChannelOpts(),
+					Channels:          []ibc.CreateChannelOptions{ibc.DefaultChannelOpts()},
+				})
+		})
+	})
+}
+
+func TestLink_ChannelOn(t *testing.T) {
+	cf := interchaintest.NewBuiltinChainFactory(zap.NewNop(), []*interchaintest.ChainSpec{
+		{Name: "gaia", ChainName: "g1", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-0"}},
+		{Name: "gaia", ChainName: "g2", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-1"}},
+		{Name: "gaia", ChainName: "g3", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-2"}},
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	l := &interchaintest.Link{
+		Path:   "p",
+		Chain1: interchaintest.LinkEnd{Chain: chains[0], ClientID: "07-tendermint-0", ConnectionID: "connection-0"},
+		Chain2: interchaintest.LinkEnd{Chain: chains[1], ClientID: "07-tendermint-3", ConnectionID: "connection-2"},
+		Channels: []ibc.ChannelOutput{
+			{
+				PortID:         "transfer",
+				ChannelID:      "channel-0",
+				ConnectionHops: []string{"connection-0"},
+				Counterparty:   ibc.ChannelCounterparty{PortID: "transfer", ChannelID: "channel-4"},
+			},
+			{
+				PortID:         "icacontroller-cosmos1abc",
+				ChannelID:      "channel-1",
+				Ordering:       "ORDER_ORDERED",
+				ConnectionHops: []string{"connection-0"},
+				Counterparty:   ibc.ChannelCounterparty{PortID: "icahost", ChannelID: "channel-5"},
+			},
+		},
+	}
+
+	ch, ok := l.ChannelOn(chains[0], "transfer")
+	require.True(t, ok)
+	require.Equal(t, "channel-0", ch.ChannelID)
+	require.Equal(t, "channel-4", ch.Counterparty.ChannelID)
+
+	ch, ok = l.ChannelOn(chains[1], "icahost")
+	require.True(t, ok)
+	require.Equal(t, ibc.ChannelOutput{
+		PortID:         "icahost",
+		ChannelID:      "channel-5",
+		Ordering:       "ORDER_ORDERED",
+		ConnectionHops: []string{"connection-2"},
+		Counterparty:   ibc.ChannelCounterparty{PortID: "icacontroller-cosmos1abc", ChannelID: "channel-1"},
+	}, ch)
+
+	_, ok = l.ChannelOn(chains[1], "icacontroller-cosmos1abc")
+	require.False(t, ok)
+	_, ok = l.ChannelOn(chains[2], "transfer")
+	require.False(t, ok)
+
+	end, ok := l.Counterparty(chains[1])
+	require.True(t, ok)
+	require.Equal(t, "connection-0", end.ConnectionID)
+	_, ok = l.End(chains[2])
+	require.False(t, ok)
 }
 
 func TestInterchain_AddNil(t *testing.T) {
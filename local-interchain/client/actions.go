@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// Chain drives a node of a chain of the network.
+type Chain struct {
+	c         *Client
+	chainID   string
+	nodeIndex int
+}
+
+// Chain returns the first node of the chain with the given ID.
+func (c *Client) Chain(chainID string) *Chain {
+	return &Chain{c: c, chainID: chainID}
+}
+
+// Node returns the node of the chain with the given index.
+func (ch *Chain) Node(index int) *Chain {
+	return &Chain{c: ch.c, chainID: ch.chainID, nodeIndex: index}
+}
+
+// Action sends an action to the node and returns its output, such as the stdout of a command.
+// cmd may use the %RPC%, %CHAIN_ID% and %HOME% placeholders, replaced by the values of the node.
+func (ch *Chain) Action(ctx context.Context, action, cmd string) ([]byte, error) {
+	return legacy(ch.c.post(ctx, "/", handlers.ActionHandler{
+		ChainId:   ch.chainID,
+		NodeIndex: ch.nodeIndex,
+		Action:    action,
+		Cmd:       cmd,
+		AuthKey:   ch.c.authKey,
+	}, nil))
+}
+
+// Query runs a query command of the chain binary, e.g. "bank balances <address>".
+// If the command fails, its output is returned along with an *Error.
+func (ch *Chain) Query(ctx context.Context, cmd string) (*handlers.CommandResponse, error) {
+	return ch.command(ctx, "query", cmd)
+}
+
+// Bin runs a command of the chain binary, e.g. "status --node=%RPC%".
+// If the command fails, its output is returned along with an *Error.
+func (ch *Chain) Bin(ctx context.Context, cmd string) (*handlers.CommandResponse, error) {
+	return ch.command(ctx, "bin", cmd)
+}
+
+// Exec runs a command in the container of the node.
+// If the command fails, its output is returned along with an *Error.
+func (ch *Chain) Exec(ctx context.Context, cmd string) (*handlers.CommandResponse, error) {
+	return ch.command(ctx, "exec", cmd)
+}
+
+// command runs cmd, split on whitespace, with the v2 command route of the node.
+func (ch *Chain) command(ctx context.Context, route, cmd string) (*handlers.CommandResponse, error) {
+	path := fmt.Sprintf("%s/chains/%s/nodes/%d/%s", handlers.V2Prefix, url.PathEscape(ch.chainID), ch.nodeIndex, route)
+	bz, err := ch.c.post(ctx, path, handlers.CommandRequest{Args: strings.Fields(cmd)}, ch.c.bearer())
+
+	// A failing command is reported with its output.
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity {
+		var res handlers.CommandResponse
+		if json.Unmarshal(apiErr.body, &res) == nil {
+			return &res, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res handlers.CommandResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode command response: %w", err)
+	}
+	return &res, nil
+}
+
+// RecoverKey adds the key keyName recovered from mnemonic to the keyring of the node.
+func (ch *Chain) RecoverKey(ctx context.Context, keyName, mnemonic string) error {
+	_, err := ch.Action(ctx, "recover-key", specialCmd("keyname", keyName, "mnemonic", mnemonic))
+	return err
+}
+
+// OverwriteGenesisFile replaces the genesis file of the node.
+func (ch *Chain) OverwriteGenesisFile(ctx context.Context, genesis []byte) error {
+	_, err := ch.Action(ctx, "overwrite-genesis-file", specialCmd("new_genesis", string(genesis)))
+	return err
+}
+
+// AddFullNodes adds amount full nodes to the chain.
+func (ch *Chain) AddFullNodes(ctx context.Context, amount int) error {
+	_, err := ch.Action(ctx, "add-full-nodes", specialCmd("amount", strconv.Itoa(amount)))
+	return err
+}
+
+// DumpContractState returns the state of the CosmWasm contract at the given height.
+func (ch *Chain) DumpContractState(ctx context.Context, contract string, height int64) ([]cosmos.ContractStateModels, error) {
+	bz, err := ch.Action(ctx, "dump-contract-state", specialCmd("contract", contract, "height", strconv.FormatInt(height, 10)))
+	if err != nil {
+		return nil, err
+	}
+	var models []cosmos.ContractStateModels
+	if err := json.Unmarshal(bz, &models); err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+// Faucet sends amount of the chain's denom to address from the faucet account.
+func (ch *Chain) Faucet(ctx context.Context, address string, amount sdkmath.Int) error {
+	path := fmt.Sprintf("%s/chains/%s/faucet", handlers.V2Prefix, url.PathEscape(ch.chainID))
+	_, err := ch.c.post(ctx, path, handlers.FaucetRequest{Address: address, Amount: amount.String()}, ch.c.bearer())
+	return err
+}
+
+// GetChannels returns the IBC channels of the chain known to the relayer.
+func (ch *Chain) GetChannels(ctx context.Context) ([]ibc.ChannelOutput, error) {
+	bz, err := ch.Action(ctx, "get_channels", "")
+	if err != nil {
+		return nil, err
+	}
+	var channels []ibc.ChannelOutput
+	if err := json.Unmarshal(bz, &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// StartRelayer starts the relayer of the network on the given paths.
+func (ch *Chain) StartRelayer(ctx context.Context, paths ...string) error {
+	return ch.relayerAction(ctx, "start-relayer", strings.Join(paths, ","))
+}
+
+// StopRelayer stops the relayer of the network.
+func (ch *Chain) StopRelayer(ctx context.Context) error {
+	return ch.relayerAction(ctx, "stop-relayer", "")
+}
+
+// RelayerExec runs a command of the relayer binary, e.g. "rly q channels localjuno-1", and returns its output.
+func (ch *Chain) RelayerExec(ctx context.Context, cmd string) ([]byte, error) {
+	return ch.Action(ctx, "relayer-exec", cmd)
+}
+
+// relayerAction sends an action expected to output nothing, reporting any output as an error.
+func (ch *Chain) relayerAction(ctx context.Context, action, cmd string) error {
+	bz, err := ch.Action(ctx, action, cmd)
+	if err != nil {
+		return err
+	}
+	if out := strings.TrimSpace(string(bz)); out != "{}" {
+		return &Error{StatusCode: http.StatusOK, Message: out}
+	}
+	return nil
+}
+
+// KillAll stops the relayer and every node of the network, and shuts down the server in the background.
+func (c *Client) KillAll(ctx context.Context) error {
+	_, err := legacy(c.post(ctx, "/", handlers.ActionHandler{
+		Action:  "kill-all",
+		AuthKey: c.authKey,
+	}, nil))
+	return err
+}
+
+// Shutdown stops the network in the background. If persist is set, its stopped containers and volumes
+// are kept, so that it can be resumed with `local-ic resume`.
+func (c *Client) Shutdown(ctx context.Context, persist bool) error {
+	_, err := c.post(ctx, handlers.V2Prefix+"/shutdown", handlers.ShutdownRequest{Persist: persist}, c.bearer())
+	return err
+}
+
+// AddChain starts the chain on the running network and links each of its IBC paths to the running chain sharing it.
+// It returns once the chain produces blocks and its paths are relayed.
+func (c *Client) AddChain(ctx context.Context, chain types.Chain) (*handlers.ChainResponse, error) {
+	bz, err := c.post(ctx, handlers.V2Prefix+"/chains", chain, c.bearer())
+	if err != nil {
+		return nil, err
+	}
+	var res handlers.ChainResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode chain: %w", err)
+	}
+	return &res, nil
+}
+
+// AddPath links a new IBC path between two running chains and restarts the relayer on every path.
+func (c *Client) AddPath(ctx context.Context, name, chainID1, chainID2 string) error {
+	_, err := c.post(ctx, handlers.V2Prefix+"/relayer/paths", handlers.AddPathRequest{
+		Name:     name,
+		ChainIDs: []string{chainID1, chainID2},
+	}, c.bearer())
+	return err
+}
+
+// bearer returns the header authorizing v2 requests changing the network, if the client has an auth key.
+func (c *Client) bearer() http.Header {
+	header := http.Header{}
+	if c.authKey != "" {
+		header.Set("Authorization", "Bearer "+c.authKey)
+	}
+	return header
+}
+
+// specialCmd returns the command of an action taking key=value arguments.
+func specialCmd(kvs ...string) string {
+	if len(kvs)%2 != 0 {
+		panic(fmt.Errorf("odd number of key value arguments: %d", len(kvs)))
+	}
+	parts := make([]string, 0, len(kvs)/2)
+	for i := 0; i < len(kvs); i += 2 {
+		parts = append(parts, kvs[i]+"="+kvs[i+1])
+	}
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,149 @@
+// Package client is a Go client for the REST API of a running local-interchain network,
+// as started by `local-ic start`.
+//
+//	c := client.New("http://127.0.0.1:8080", client.WithAuthKey("secret"))
+//	info, err := c.Info(ctx)
+//	balances, err := c.Chain("localjuno-1").Query(ctx, "bank balances juno1...")
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client drives a running local-interchain network through its REST API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	authKey    string
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests, e.g. to set a timeout.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithAuthKey sets the auth key sent with actions and uploads,
+// required when the network was started with --auth-key.
+func WithAuthKey(authKey string) Option {
+	return func(c *Client) {
+		c.authKey = authKey
+	}
+}
+
+// New returns a client of the local-interchain API at apiAddr, e.g. "http://127.0.0.1:8080".
+func New(apiAddr string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(apiAddr, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Error is an error reported by the local-interchain API.
+type Error struct {
+	// HTTP status code of the response.
+	StatusCode int
+
+	Message string
+
+	body []byte
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("local-interchain API error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// get sends a GET request to path with the given query parameters and returns the response body.
+func (c *Client) get(ctx context.Context, path string, params url.Values) ([]byte, error) {
+	u := c.baseURL + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// post sends body as JSON to path with the given extra headers and returns the response body.
+func (c *Client) post(ctx context.Context, path string, body any, header http.Header) ([]byte, error) {
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(bz))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req)
+}
+
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg := strings.TrimSpace(string(bz))
+		if apiErr := parseError(bz); apiErr != "" {
+			msg = apiErr
+		}
+		return nil, &Error{StatusCode: resp.StatusCode, Message: msg, body: bz}
+	}
+	return bz, nil
+}
+
+// legacy returns the response of a route of the legacy API, which reports most errors
+// as {"error": "..."} with a 200 status.
+func legacy(bz []byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]json.RawMessage
+	if json.Unmarshal(bz, &res) == nil && len(res) == 1 {
+		if apiErr := parseError(bz); apiErr != "" {
+			return nil, &Error{StatusCode: http.StatusOK, Message: apiErr, body: bz}
+		}
+	}
+	return bz, nil
+}
+
+// parseError returns the "error" of an API error response, or "" if bz has none.
+func parseError(bz []byte) string {
+	var res map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return ""
+	}
+	var msg string
+	if err := json.Unmarshal(res["error"], &msg); err != nil {
+		return ""
+	}
+	return msg
+}
@@ -0,0 +1,183 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	sdkmath "cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/client"
+	localinterchainv1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1/localinterchainv1connect"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	var actions []handlers.ActionHandler
+	mux := http.NewServeMux()
+	mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("request") {
+		case "":
+			_, _ = w.Write([]byte(`{"logs":{"start_time":1},"chains":[],"relayer":{}}`))
+		case "height":
+			require.Equal(t, "localjuno-1", q.Get("chain_id"))
+			require.Equal(t, "1", q.Get("node_index"))
+			_, _ = w.Write([]byte("42"))
+		default:
+			_, _ = w.Write([]byte(`{"error": "invalid get param: ` + q.Get("request") + `. does not exist"}`))
+		}
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		var ah handlers.ActionHandler
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ah))
+		actions = append(actions, ah)
+
+		switch ah.Action {
+		case "get_channels":
+			bz, _ := json.Marshal([]ibc.ChannelOutput{{ChannelID: "channel-0", PortID: "transfer"}})
+			_, _ = w.Write(bz)
+		case "stop-relayer":
+			_, _ = w.Write([]byte("{}"))
+		case "start-relayer":
+			_, _ = w.Write([]byte("failed to start relayer"))
+		default:
+			_, _ = w.Write([]byte(`{"sent_funds":"5"}`))
+		}
+	})
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "cosmwasm", r.Header.Get("Upload-Type"))
+		_, _ = w.Write([]byte(`{"code_id":7}`))
+	})
+	mux.HandleFunc("/v2/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req handlers.ShutdownRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.True(t, req.Persist)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/v2/chains", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req types.Chain
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"chain_id":"` + req.ChainID + `","nodes":1,"ibc_paths":["juno-ibc-2"],"actions":["query"]}`))
+	})
+	mux.HandleFunc("/v2/chains/localjuno-1/nodes/1/query", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req handlers.CommandRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, []string{"bank", "balances", "juno1abc"}, req.Args)
+		// Valid command output that looks like a legacy error.
+		_, _ = w.Write([]byte(`{"stdout":"{\"error\":\"none\"}","stderr":"","json":{"error":"none"}}`))
+	})
+	mux.HandleFunc("/v2/chains/localjuno-1/nodes/0/exec", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"stdout":"","stderr":"no such file","error":"exit code 1"}`))
+	})
+	mux.HandleFunc("/v2/chains/localjuno-1/faucet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req handlers.FaucetRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, handlers.FaucetRequest{Address: "juno1abc", Amount: "5"}, req)
+		_, _ = w.Write([]byte(`{"sent_funds":"5"}`))
+	})
+	mux.HandleFunc("/v2/relayer/paths", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"error":"ibc path \"juno-ibc-1\" already exists between [localjuno-1 localjuno-2]"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	c := client.New(srv.URL+"/", client.WithAuthKey("secret"))
+
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.Logs.StartTime)
+
+	juno := c.Chain("localjuno-1")
+	h, err := juno.Node(1).Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), h)
+
+	_, err = juno.Name(ctx)
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "invalid get param: name. does not exist", apiErr.Message)
+
+	channels, err := juno.GetChannels(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "channel-0", channels[0].ChannelID)
+
+	res, err := juno.Node(1).Query(ctx, "bank balances  juno1abc")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"error":"none"}`, string(res.JSON))
+
+	res, err = juno.Exec(ctx, "cat /missing")
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	require.Equal(t, "exit code 1", apiErr.Message)
+	require.Equal(t, "no such file", res.Stderr)
+
+	require.NoError(t, juno.Faucet(ctx, "juno1abc", sdkmath.NewInt(5)))
+
+	require.NoError(t, juno.RecoverKey(ctx, "acc0", "word word"))
+	require.Equal(t, handlers.ActionHandler{
+		ChainId: "localjuno-1",
+		Action:  "recover-key",
+		Cmd:     "keyname=acc0;mnemonic=word word",
+		AuthKey: "secret",
+	}, actions[len(actions)-1])
+
+	require.NoError(t, juno.StopRelayer(ctx))
+	require.ErrorAs(t, juno.StartRelayer(ctx, "juno-ibc-1"), &apiErr)
+	require.Equal(t, "failed to start relayer", apiErr.Message)
+	require.Equal(t, "juno-ibc-1", actions[len(actions)-1].Cmd)
+
+	codeID, err := juno.StoreContract(ctx, "acc0", "/tmp/contract.wasm")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), codeID)
+
+	chain, err := c.AddChain(ctx, types.Chain{ChainID: "localjuno-3", IBCPaths: []string{"juno-ibc-2"}})
+	require.NoError(t, err)
+	require.Equal(t, "localjuno-3", chain.ChainID)
+	require.Equal(t, []string{"juno-ibc-2"}, chain.IBCPaths)
+
+	require.ErrorAs(t, c.AddPath(ctx, "juno-ibc-1", "localjuno-1", "localjuno-3"), &apiErr)
+	require.Equal(t, http.StatusConflict, apiErr.StatusCode)
+
+	require.NoError(t, c.Shutdown(ctx, true))
+}
+
+// faucetService records the auth header of faucet requests.
+type faucetService struct {
+	localinterchainv1connect.UnimplementedLocalInterchainServiceHandler
+
+	auth string
+}
+
+func (s *faucetService) Faucet(ctx context.Context, req *connect.Request[localinterchainv1.FaucetRequest]) (*connect.Response[localinterchainv1.FaucetResponse], error) {
+	s.auth = req.Header().Get("Authorization")
+	return connect.NewResponse(&localinterchainv1.FaucetResponse{}), nil
+}
+
+func TestClientRPC(t *testing.T) {
+	svc := &faucetService{}
+	path, h := localinterchainv1connect.NewLocalInterchainServiceHandler(svc)
+	mux := http.NewServeMux()
+	mux.Handle(path, h)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	rpc := client.New(srv.URL, client.WithAuthKey("secret")).RPC()
+	_, err := rpc.Faucet(context.Background(), connect.NewRequest(&localinterchainv1.FaucetRequest{ChainId: "localjuno-1"}))
+	require.NoError(t, err)
+	require.Equal(t, "Bearer secret", svc.auth)
+}
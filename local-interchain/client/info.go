@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+)
+
+// Route is an endpoint of the API.
+type Route struct {
+	Path    string   `json:"path"`
+	Methods []string `json:"methods"`
+}
+
+// Routes returns the endpoints served by the API.
+func (c *Client) Routes(ctx context.Context) ([]Route, error) {
+	bz, err := legacy(c.get(ctx, "/", nil))
+	if err != nil {
+		return nil, err
+	}
+	var routes []Route
+	if err := json.Unmarshal(bz, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// Info returns the chains, IBC channels and relayer of the network. Genesis account mnemonics are hidden.
+func (c *Client) Info(ctx context.Context) (*handlers.GetInfo, error) {
+	bz, err := legacy(c.get(ctx, "/info", nil))
+	if err != nil {
+		return nil, err
+	}
+	var info handlers.GetInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// info requests information about the node of ch.
+func (ch *Chain) info(ctx context.Context, request string, params url.Values) ([]byte, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("request", request)
+	params.Set("chain_id", ch.chainID)
+	params.Set("node_index", strconv.Itoa(ch.nodeIndex))
+	return legacy(ch.c.get(ctx, "/info", params))
+}
+
+// Config returns the configuration of the chain.
+func (ch *Chain) Config(ctx context.Context) (*handlers.IbcChainConfigAlias, error) {
+	bz, err := ch.info(ctx, "config", nil)
+	if err != nil {
+		return nil, err
+	}
+	var cfg handlers.IbcChainConfigAlias
+	if err := json.Unmarshal(bz, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Height returns the latest height of the node.
+func (ch *Chain) Height(ctx context.Context) (int64, error) {
+	bz, err := ch.info(ctx, "height", nil)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(bz), 10, 64)
+}
+
+// Name returns the name of the node.
+func (ch *Chain) Name(ctx context.Context) (string, error) {
+	bz, err := ch.info(ctx, "name", nil)
+	return string(bz), err
+}
+
+// ContainerID returns the ID of the docker container of the node.
+func (ch *Chain) ContainerID(ctx context.Context) (string, error) {
+	bz, err := ch.info(ctx, "container_id", nil)
+	return string(bz), err
+}
+
+// HostName returns the host name of the node in the docker network.
+func (ch *Chain) HostName(ctx context.Context) (string, error) {
+	bz, err := ch.info(ctx, "hostname", nil)
+	return string(bz), err
+}
+
+// HomeDir returns the home directory of the node in its container.
+func (ch *Chain) HomeDir(ctx context.Context) (string, error) {
+	bz, err := ch.info(ctx, "home_dir", nil)
+	return string(bz), err
+}
+
+// IsAboveSDK47 reports whether the chain runs Cosmos SDK v0.47 or later.
+func (ch *Chain) IsAboveSDK47(ctx context.Context) (bool, error) {
+	bz, err := ch.info(ctx, "is_above_sdk_47", nil)
+	if err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(string(bz))
+}
+
+// HasCommand reports whether the chain binary has the given command, e.g. "tx wasm".
+func (ch *Chain) HasCommand(ctx context.Context, command string) (bool, error) {
+	bz, err := ch.info(ctx, "has_command", url.Values{"command": {command}})
+	if err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(string(bz))
+}
+
+// ReadFile returns the content of the file at relPath in the home directory of the node.
+func (ch *Chain) ReadFile(ctx context.Context, relPath string) ([]byte, error) {
+	return ch.info(ctx, "read_file", url.Values{"relative_path": {relPath}})
+}
+
+// BuildInformation returns the build information of the chain binary.
+func (ch *Chain) BuildInformation(ctx context.Context) (*cosmos.BinaryBuildInformation, error) {
+	bz, err := ch.info(ctx, "build_information", nil)
+	if err != nil {
+		return nil, err
+	}
+	var bi cosmos.BinaryBuildInformation
+	if err := json.Unmarshal(bz, &bi); err != nil {
+		return nil, err
+	}
+	return &bi, nil
+}
+
+// GenesisFileContent returns the genesis file of the node.
+func (ch *Chain) GenesisFileContent(ctx context.Context) ([]byte, error) {
+	return ch.info(ctx, "genesis_file_content", nil)
+}
+
+// Peer returns the peer address of the node, as "<node id>@<host>:<port>".
+func (ch *Chain) Peer(ctx context.Context) (string, error) {
+	bz, err := ch.info(ctx, "peer", nil)
+	return string(bz), err
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1/localinterchainv1connect"
+)
+
+// RPC returns a client of the gRPC and Connect service served on the address of the API,
+// which streams blocks and logs. It uses the Connect protocol over the HTTP client of c
+// and sends the auth key of c, if any, as a bearer token.
+func (c *Client) RPC() localinterchainv1connect.LocalInterchainServiceClient {
+	return localinterchainv1connect.NewLocalInterchainServiceClient(
+		c.httpClient,
+		c.baseURL,
+		connect.WithInterceptors(bearerInterceptor{authKey: c.authKey}),
+	)
+}
+
+// bearerInterceptor sets the "Authorization: Bearer <key>" header on requests, if the key is set.
+type bearerInterceptor struct {
+	authKey string
+}
+
+func (b bearerInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if b.authKey != "" {
+			req.Header().Set("Authorization", "Bearer "+b.authKey)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (b bearerInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		if b.authKey != "" {
+			conn.RequestHeader().Set("Authorization", "Bearer "+b.authKey)
+		}
+		return conn
+	}
+}
+
+func (b bearerInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
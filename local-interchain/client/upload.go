@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+)
+
+// UploadFile copies the file at filePath, on the machine running local-interchain,
+// to the home directory of the node and returns its location in the container.
+func (ch *Chain) UploadFile(ctx context.Context, filePath string) (string, error) {
+	bz, err := legacy(ch.c.post(ctx, "/upload", ch.uploader(filePath, ""), nil))
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Location string `json:"location"`
+	}
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return "", err
+	}
+	return res.Location, nil
+}
+
+// StoreContract stores the CosmWasm contract at filePath, on the machine running local-interchain,
+// on the chain with keyName and returns its code ID.
+func (ch *Chain) StoreContract(ctx context.Context, keyName, filePath string) (uint64, error) {
+	bz, err := legacy(ch.c.post(ctx, "/upload", ch.uploader(filePath, keyName), http.Header{"Upload-Type": {"cosmwasm"}}))
+	if err != nil {
+		return 0, err
+	}
+	var res struct {
+		CodeID uint64 `json:"code_id"`
+	}
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return 0, err
+	}
+	return res.CodeID, nil
+}
+
+func (ch *Chain) uploader(filePath, keyName string) handlers.Uploader {
+	return handlers.Uploader{
+		ChainId:   ch.chainID,
+		NodeIndex: ch.nodeIndex,
+		FilePath:  filePath,
+		KeyName:   keyName,
+		AuthKey:   ch.c.authKey,
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/client"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/tui"
+)
+
+const FlagRefreshInterval = "refresh-interval"
+
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Shows a terminal dashboard of the running network",
+	Long: `Shows the chains and heights of the running network, the nodes, channels, recent transactions
+and logs of the selected chain, and the status of the relayer. Keys at the top send funds from
+the faucet of the selected chain and restart the relayer.`,
+	Example: `local-ic dashboard
+local-ic dashboard --api-address=http://127.0.0.1:8080 --auth-key=secret --refresh-interval=5s
+`,
+	Aliases: []string{"dash"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiAddr, _ := cmd.Flags().GetString(FlagAPIAddressOverride)
+		authKey, _ := cmd.Flags().GetString(FlagAuthKey)
+		interval, _ := cmd.Flags().GetDuration(FlagRefreshInterval)
+
+		c := client.New(apiAddr, client.WithAuthKey(authKey))
+		model := tui.NewModel(cmd.Context(), c.RPC(), apiAddr)
+		return model.Run(tview.NewApplication(), interval)
+	},
+}
+
+func init() {
+	dashboardCmd.Flags().String(FlagAPIAddressOverride, "http://127.0.0.1:8080", "override the default API address")
+	dashboardCmd.Flags().String(FlagAuthKey, "", "auth key of the network, if it was started with one")
+	dashboardCmd.Flags().Duration(FlagRefreshInterval, 2*time.Second, "interval between refreshes of the chains and relayer")
+}
@@ -1,15 +1,12 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/client"
 )
 
 const (
@@ -38,13 +35,9 @@ var interactCmd = &cobra.Command{
 	},
 	Run: func(cmd *cobra.Command, args []string) {
 
-		ah := &handlers.ActionHandler{
-			ChainId: args[0],
-			Action:  args[1],
-		}
-
+		var cmdArg string
 		if len(args) > 2 {
-			ah.Cmd = strings.Join(args[2:], " ")
+			cmdArg = strings.Join(args[2:], " ")
 		}
 
 		authKey, err := cmd.Flags().GetString(FlagAuthKey)
@@ -57,46 +50,21 @@ var interactCmd = &cobra.Command{
 			panic(err)
 		}
 
-		ah.AuthKey = authKey
-		ah.NodeIndex = nodeIdx
-
 		apiAddr, err := cmd.Flags().GetString(FlagAPIAddressOverride)
 		if err != nil {
 			panic(err)
 		}
 
-		res := makeHttpReq(apiAddr, ah)
-		fmt.Println(res)
+		c := client.New(apiAddr, client.WithAuthKey(authKey))
+		res, err := c.Chain(args[0]).Node(nodeIdx).Action(cmd.Context(), args[1], cmdArg)
+		if err != nil {
+			var apiErr *client.Error
+			if !errors.As(err, &apiErr) {
+				panic(err)
+			}
+			fmt.Printf(`{"error":%q}`+"\n", apiErr.Message)
+			return
+		}
+		fmt.Println(string(res))
 	},
 }
-
-func makeHttpReq(apiEndpoint string, ah *handlers.ActionHandler) string {
-	client := &http.Client{}
-
-	//	curl -X POST -H "Content-Type: application/json" -d '{
-	//		"chain_id": "localjuno-1",
-	//		"action": "query",
-	//		"cmd": "bank balances juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl"
-	//	  }' http://127.0.0.1:8080/
-	jsonData, err := json.Marshal(ah)
-	if err != nil {
-		panic(err)
-	}
-
-	req, err := http.NewRequest("POST", apiEndpoint, bytes.NewReader(jsonData))
-	if err != nil {
-		panic(err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-	if err != nil {
-		panic(err)
-	}
-	defer resp.Body.Close()
-	bodyText, err := io.ReadAll(resp.Body)
-	if err != nil {
-		panic(err)
-	}
-
-	return string(bodyText)
-}
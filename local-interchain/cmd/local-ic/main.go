@@ -14,7 +14,10 @@ func main() {
 	rootCmd.AddCommand(chainsCmd)
 	rootCmd.AddCommand(newChainCmd)
 	rootCmd.AddCommand(startCmd)
+	rootCmd.AddCommand(stopCmd)
+	rootCmd.AddCommand(resumeCmd)
 	rootCmd.AddCommand(interactCmd)
+	rootCmd.AddCommand(dashboardCmd)
 	rootCmd.AddCommand(&cobra.Command{
 		Use:     "version",
 		Aliases: []string{"ver"},
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+)
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume <name>",
+	Short: "Resumes a network stopped with --persist, without a new genesis",
+	Long: `Resumes a network stopped with 'local-ic stop --persist' or started with 'local-ic start --keep'.
+The name is the one of the config the network was started from, without its extension.
+The chains keep their state, chain IDs and host ports.`,
+	Example: `local-ic resume base_ibc
+local-ic resume mychain --api-port 8081
+`,
+	Args: cobra.ExactArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		names, _ := interchain.ListPersistedNetworks(GetDirectory())
+		return names, cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		installDir := GetDirectory()
+
+		names, err := interchain.ListPersistedNetworks(installDir)
+		if err != nil {
+			return err
+		}
+		name := interchain.NetworkName(args[0])
+		if !contains(names, name) {
+			return fmt.Errorf("no persisted network named %q, persisted networks: [%s]", name, strings.Join(names, ", "))
+		}
+
+		apiAddr, _ := cmd.Flags().GetString(FlagAPIAddressOverride)
+		apiPort, _ := cmd.Flags().GetUint16(FlagAPIPortOverride)
+		keep, _ := cmd.Flags().GetBool(FlagKeep)
+
+		interchain.ResumeChain(installDir, name, &types.AppStartConfig{
+			Address: apiAddr,
+			Port:    apiPort,
+			AuthKey: cmd.Flag(FlagAuthKey).Value.String(),
+			Keep:    keep,
+		})
+		return nil
+	},
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	resumeCmd.Flags().String(FlagAPIAddressOverride, "127.0.0.1", "override the default API address")
+	resumeCmd.Flags().Uint16(FlagAPIPortOverride, 8080, "override the default API port")
+	resumeCmd.Flags().String(FlagAuthKey, "", "require an auth key to use the internal API")
+	resumeCmd.Flags().Bool(FlagKeep, false, "keep the stopped docker containers, volumes and networks on shutdown")
+}
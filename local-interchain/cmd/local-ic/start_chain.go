@@ -17,11 +17,13 @@ const (
 	FlagAPIAddressOverride = "api-address"
 	FlagAPIPortOverride    = "api-port"
 
+	FlagRelayerType         = "relayer-type"
 	FlagRelayerImage        = "relayer-image"
 	FlagRelayerVersion      = "relayer-version"
 	FlagRelayerUidGid       = "relayer-uidgid"
 	FlagRelayerStartupFlags = "relayer-startup-flags"
 	FlagAuthKey             = "auth-key"
+	FlagKeep                = "keep"
 )
 
 var startCmd = &cobra.Command{
@@ -74,10 +76,22 @@ local-ic start https://pastebin.com/raw/Ummk4DTM
 		apiAddr, _ := cmd.Flags().GetString(FlagAPIAddressOverride)
 		apiPort, _ := cmd.Flags().GetUint16(FlagAPIPortOverride)
 
-		relayerImg := cmd.Flag(FlagRelayerImage).Value.String()
-		relayerVer := cmd.Flag(FlagRelayerVersion).Value.String()
-		relayerUidGid := cmd.Flag(FlagRelayerUidGid).Value.String()
-		relayerFlags := strings.Split(cmd.Flag(FlagRelayerStartupFlags).Value.String(), " ")
+		// Relayer flags only override the config when set.
+		changed := func(flag string) string {
+			if !cmd.Flags().Changed(flag) {
+				return ""
+			}
+			return cmd.Flag(flag).Value.String()
+		}
+		relayerType := changed(FlagRelayerType)
+		relayerImg := changed(FlagRelayerImage)
+		relayerVer := changed(FlagRelayerVersion)
+		relayerUidGid := changed(FlagRelayerUidGid)
+		var relayerFlags []string
+		if cmd.Flags().Changed(FlagRelayerStartupFlags) {
+			relayerFlags = strings.Fields(cmd.Flag(FlagRelayerStartupFlags).Value.String())
+		}
+		keep, _ := cmd.Flags().GetBool(FlagKeep)
 
 		interchain.StartChain(parentDir, configPath, &types.AppStartConfig{
 			Address: apiAddr,
@@ -85,6 +99,7 @@ local-ic start https://pastebin.com/raw/Ummk4DTM
 			Cfg:     config,
 
 			Relayer: types.Relayer{
+				Type: relayerType,
 				DockerImage: ibc.DockerImage{
 					Repository: relayerImg,
 					Version:    relayerVer,
@@ -94,6 +109,7 @@ local-ic start https://pastebin.com/raw/Ummk4DTM
 			},
 
 			AuthKey: cmd.Flag(FlagAuthKey).Value.String(),
+			Keep:    keep,
 		})
 	},
 }
@@ -123,10 +139,12 @@ func init() {
 	startCmd.Flags().String(FlagAPIAddressOverride, "127.0.0.1", "override the default API address")
 	startCmd.Flags().Uint16(FlagAPIPortOverride, 8080, "override the default API port")
 
-	startCmd.Flags().String(FlagRelayerImage, "ghcr.io/cosmos/relayer", "override the docker relayer image")
+	startCmd.Flags().String(FlagRelayerType, "rly", "override the relayer type of the config (rly, hermes, hyperspace)")
+	startCmd.Flags().String(FlagRelayerImage, "ghcr.io/cosmos/relayer", "override the docker relayer image (rly default)")
 	startCmd.Flags().String(FlagRelayerVersion, "latest", "override the default relayer version")
-	startCmd.Flags().String(FlagRelayerUidGid, "100:1000", "override the default image UID:GID")
-	startCmd.Flags().String(FlagRelayerStartupFlags, "--block-history=100", "override the default relayer startup flags")
+	startCmd.Flags().String(FlagRelayerUidGid, "100:1000", "override the default image UID:GID (rly default)")
+	startCmd.Flags().String(FlagRelayerStartupFlags, "--block-history=100", "override the default relayer startup flags (rly default)")
 
 	startCmd.Flags().String(FlagAuthKey, "", "require an auth key to use the internal API")
+	startCmd.Flags().Bool(FlagKeep, false, "keep the stopped docker containers, volumes and networks on shutdown")
 }
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/client"
+)
+
+const FlagPersist = "persist"
+
+var stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stops the running network",
+	Example: `local-ic stop
+local-ic stop --persist
+local-ic stop --api-address=http://127.0.0.1:8080 --auth-key=secret
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiAddr, _ := cmd.Flags().GetString(FlagAPIAddressOverride)
+		authKey, _ := cmd.Flags().GetString(FlagAuthKey)
+		persist, _ := cmd.Flags().GetBool(FlagPersist)
+
+		c := client.New(apiAddr, client.WithAuthKey(authKey))
+		if err := c.Shutdown(cmd.Context(), persist); err != nil {
+			return err
+		}
+
+		if persist {
+			fmt.Println("Stopping the network, its containers and volumes are kept to resume it with `local-ic resume <name>`")
+		} else {
+			fmt.Println("Stopping the network")
+		}
+		return nil
+	},
+}
+
+func init() {
+	stopCmd.Flags().String(FlagAPIAddressOverride, "http://127.0.0.1:8080", "override the default API address")
+	stopCmd.Flags().String(FlagAuthKey, "", "auth key of the network, if it was started with one")
+	stopCmd.Flags().Bool(FlagPersist, false, "keep the stopped containers and volumes to resume the network later")
+}
@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: localinterchain/v1/localinterchain.proto
+
+package localinterchainv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LocalInterchainServiceName is the fully-qualified name of the LocalInterchainService service.
+	LocalInterchainServiceName = "localinterchain.v1.LocalInterchainService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LocalInterchainServiceInfoProcedure is the fully-qualified name of the LocalInterchainService's
+	// Info RPC.
+	LocalInterchainServiceInfoProcedure = "/localinterchain.v1.LocalInterchainService/Info"
+	// LocalInterchainServiceQueryProcedure is the fully-qualified name of the LocalInterchainService's
+	// Query RPC.
+	LocalInterchainServiceQueryProcedure = "/localinterchain.v1.LocalInterchainService/Query"
+	// LocalInterchainServiceBinProcedure is the fully-qualified name of the LocalInterchainService's
+	// Bin RPC.
+	LocalInterchainServiceBinProcedure = "/localinterchain.v1.LocalInterchainService/Bin"
+	// LocalInterchainServiceExecProcedure is the fully-qualified name of the LocalInterchainService's
+	// Exec RPC.
+	LocalInterchainServiceExecProcedure = "/localinterchain.v1.LocalInterchainService/Exec"
+	// LocalInterchainServiceFaucetProcedure is the fully-qualified name of the LocalInterchainService's
+	// Faucet RPC.
+	LocalInterchainServiceFaucetProcedure = "/localinterchain.v1.LocalInterchainService/Faucet"
+	// LocalInterchainServiceUploadProcedure is the fully-qualified name of the LocalInterchainService's
+	// Upload RPC.
+	LocalInterchainServiceUploadProcedure = "/localinterchain.v1.LocalInterchainService/Upload"
+	// LocalInterchainServiceStartRelayerProcedure is the fully-qualified name of the
+	// LocalInterchainService's StartRelayer RPC.
+	LocalInterchainServiceStartRelayerProcedure = "/localinterchain.v1.LocalInterchainService/StartRelayer"
+	// LocalInterchainServiceStopRelayerProcedure is the fully-qualified name of the
+	// LocalInterchainService's StopRelayer RPC.
+	LocalInterchainServiceStopRelayerProcedure = "/localinterchain.v1.LocalInterchainService/StopRelayer"
+	// LocalInterchainServiceRelayerExecProcedure is the fully-qualified name of the
+	// LocalInterchainService's RelayerExec RPC.
+	LocalInterchainServiceRelayerExecProcedure = "/localinterchain.v1.LocalInterchainService/RelayerExec"
+	// LocalInterchainServiceGetChannelsProcedure is the fully-qualified name of the
+	// LocalInterchainService's GetChannels RPC.
+	LocalInterchainServiceGetChannelsProcedure = "/localinterchain.v1.LocalInterchainService/GetChannels"
+	// LocalInterchainServiceStreamBlocksProcedure is the fully-qualified name of the
+	// LocalInterchainService's StreamBlocks RPC.
+	LocalInterchainServiceStreamBlocksProcedure = "/localinterchain.v1.LocalInterchainService/StreamBlocks"
+	// LocalInterchainServiceStreamLogsProcedure is the fully-qualified name of the
+	// LocalInterchainService's StreamLogs RPC.
+	LocalInterchainServiceStreamLogsProcedure = "/localinterchain.v1.LocalInterchainService/StreamLogs"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	localInterchainServiceServiceDescriptor            = v1.File_localinterchain_v1_localinterchain_proto.Services().ByName("LocalInterchainService")
+	localInterchainServiceInfoMethodDescriptor         = localInterchainServiceServiceDescriptor.Methods().ByName("Info")
+	localInterchainServiceQueryMethodDescriptor        = localInterchainServiceServiceDescriptor.Methods().ByName("Query")
+	localInterchainServiceBinMethodDescriptor          = localInterchainServiceServiceDescriptor.Methods().ByName("Bin")
+	localInterchainServiceExecMethodDescriptor         = localInterchainServiceServiceDescriptor.Methods().ByName("Exec")
+	localInterchainServiceFaucetMethodDescriptor       = localInterchainServiceServiceDescriptor.Methods().ByName("Faucet")
+	localInterchainServiceUploadMethodDescriptor       = localInterchainServiceServiceDescriptor.Methods().ByName("Upload")
+	localInterchainServiceStartRelayerMethodDescriptor = localInterchainServiceServiceDescriptor.Methods().ByName("StartRelayer")
+	localInterchainServiceStopRelayerMethodDescriptor  = localInterchainServiceServiceDescriptor.Methods().ByName("StopRelayer")
+	localInterchainServiceRelayerExecMethodDescriptor  = localInterchainServiceServiceDescriptor.Methods().ByName("RelayerExec")
+	localInterchainServiceGetChannelsMethodDescriptor  = localInterchainServiceServiceDescriptor.Methods().ByName("GetChannels")
+	localInterchainServiceStreamBlocksMethodDescriptor = localInterchainServiceServiceDescriptor.Methods().ByName("StreamBlocks")
+	localInterchainServiceStreamLogsMethodDescriptor   = localInterchainServiceServiceDescriptor.Methods().ByName("StreamLogs")
+)
+
+// LocalInterchainServiceClient is a client for the localinterchain.v1.LocalInterchainService
+// service.
+type LocalInterchainServiceClient interface {
+	// Info returns the chains and relayer of the network.
+	Info(context.Context, *connect.Request[v1.InfoRequest]) (*connect.Response[v1.InfoResponse], error)
+	// Query runs a query command of the chain binary against a node.
+	Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error)
+	// Bin runs the chain binary in a node's container.
+	Bin(context.Context, *connect.Request[v1.BinRequest]) (*connect.Response[v1.BinResponse], error)
+	// Exec runs a command in a node's container.
+	Exec(context.Context, *connect.Request[v1.ExecRequest]) (*connect.Response[v1.ExecResponse], error)
+	// Faucet sends funds of the chain's denom from the faucet account.
+	Faucet(context.Context, *connect.Request[v1.FaucetRequest]) (*connect.Response[v1.FaucetResponse], error)
+	// Upload copies a file into a node's home directory, or stores it as a CosmWasm contract.
+	// The first message holds the metadata of the upload, the following ones the content of the file.
+	Upload(context.Context) *connect.ClientStreamForClient[v1.UploadRequest, v1.UploadResponse]
+	// StartRelayer starts the relayer, on every path if none is given.
+	StartRelayer(context.Context, *connect.Request[v1.StartRelayerRequest]) (*connect.Response[v1.StartRelayerResponse], error)
+	// StopRelayer stops the relayer.
+	StopRelayer(context.Context, *connect.Request[v1.StopRelayerRequest]) (*connect.Response[v1.StopRelayerResponse], error)
+	// RelayerExec runs a relayer command.
+	RelayerExec(context.Context, *connect.Request[v1.RelayerExecRequest]) (*connect.Response[v1.RelayerExecResponse], error)
+	// GetChannels returns the IBC channels of a chain, as reported by the relayer.
+	GetChannels(context.Context, *connect.Request[v1.GetChannelsRequest]) (*connect.Response[v1.GetChannelsResponse], error)
+	// StreamBlocks streams the blocks of a chain as they are committed.
+	StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest]) (*connect.ServerStreamForClient[v1.StreamBlocksResponse], error)
+	// StreamLogs streams the container logs of a node, starting with lines written at the time of the call.
+	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error)
+}
+
+// NewLocalInterchainServiceClient constructs a client for the
+// localinterchain.v1.LocalInterchainService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLocalInterchainServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LocalInterchainServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &localInterchainServiceClient{
+		info: connect.NewClient[v1.InfoRequest, v1.InfoResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceInfoProcedure,
+			connect.WithSchema(localInterchainServiceInfoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		query: connect.NewClient[v1.QueryRequest, v1.QueryResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceQueryProcedure,
+			connect.WithSchema(localInterchainServiceQueryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		bin: connect.NewClient[v1.BinRequest, v1.BinResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceBinProcedure,
+			connect.WithSchema(localInterchainServiceBinMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exec: connect.NewClient[v1.ExecRequest, v1.ExecResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceExecProcedure,
+			connect.WithSchema(localInterchainServiceExecMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		faucet: connect.NewClient[v1.FaucetRequest, v1.FaucetResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceFaucetProcedure,
+			connect.WithSchema(localInterchainServiceFaucetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		upload: connect.NewClient[v1.UploadRequest, v1.UploadResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceUploadProcedure,
+			connect.WithSchema(localInterchainServiceUploadMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startRelayer: connect.NewClient[v1.StartRelayerRequest, v1.StartRelayerResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceStartRelayerProcedure,
+			connect.WithSchema(localInterchainServiceStartRelayerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stopRelayer: connect.NewClient[v1.StopRelayerRequest, v1.StopRelayerResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceStopRelayerProcedure,
+			connect.WithSchema(localInterchainServiceStopRelayerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		relayerExec: connect.NewClient[v1.RelayerExecRequest, v1.RelayerExecResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceRelayerExecProcedure,
+			connect.WithSchema(localInterchainServiceRelayerExecMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getChannels: connect.NewClient[v1.GetChannelsRequest, v1.GetChannelsResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceGetChannelsProcedure,
+			connect.WithSchema(localInterchainServiceGetChannelsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamBlocks: connect.NewClient[v1.StreamBlocksRequest, v1.StreamBlocksResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceStreamBlocksProcedure,
+			connect.WithSchema(localInterchainServiceStreamBlocksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamLogs: connect.NewClient[v1.StreamLogsRequest, v1.StreamLogsResponse](
+			httpClient,
+			baseURL+LocalInterchainServiceStreamLogsProcedure,
+			connect.WithSchema(localInterchainServiceStreamLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// localInterchainServiceClient implements LocalInterchainServiceClient.
+type localInterchainServiceClient struct {
+	info         *connect.Client[v1.InfoRequest, v1.InfoResponse]
+	query        *connect.Client[v1.QueryRequest, v1.QueryResponse]
+	bin          *connect.Client[v1.BinRequest, v1.BinResponse]
+	exec         *connect.Client[v1.ExecRequest, v1.ExecResponse]
+	faucet       *connect.Client[v1.FaucetRequest, v1.FaucetResponse]
+	upload       *connect.Client[v1.UploadRequest, v1.UploadResponse]
+	startRelayer *connect.Client[v1.StartRelayerRequest, v1.StartRelayerResponse]
+	stopRelayer  *connect.Client[v1.StopRelayerRequest, v1.StopRelayerResponse]
+	relayerExec  *connect.Client[v1.RelayerExecRequest, v1.RelayerExecResponse]
+	getChannels  *connect.Client[v1.GetChannelsRequest, v1.GetChannelsResponse]
+	streamBlocks *connect.Client[v1.StreamBlocksRequest, v1.StreamBlocksResponse]
+	streamLogs   *connect.Client[v1.StreamLogsRequest, v1.StreamLogsResponse]
+}
+
+// Info calls localinterchain.v1.LocalInterchainService.Info.
+func (c *localInterchainServiceClient) Info(ctx context.Context, req *connect.Request[v1.InfoRequest]) (*connect.Response[v1.InfoResponse], error) {
+	return c.info.CallUnary(ctx, req)
+}
+
+// Query calls localinterchain.v1.LocalInterchainService.Query.
+func (c *localInterchainServiceClient) Query(ctx context.Context, req *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error) {
+	return c.query.CallUnary(ctx, req)
+}
+
+// Bin calls localinterchain.v1.LocalInterchainService.Bin.
+func (c *localInterchainServiceClient) Bin(ctx context.Context, req *connect.Request[v1.BinRequest]) (*connect.Response[v1.BinResponse], error) {
+	return c.bin.CallUnary(ctx, req)
+}
+
+// Exec calls localinterchain.v1.LocalInterchainService.Exec.
+func (c *localInterchainServiceClient) Exec(ctx context.Context, req *connect.Request[v1.ExecRequest]) (*connect.Response[v1.ExecResponse], error) {
+	return c.exec.CallUnary(ctx, req)
+}
+
+// Faucet calls localinterchain.v1.LocalInterchainService.Faucet.
+func (c *localInterchainServiceClient) Faucet(ctx context.Context, req *connect.Request[v1.FaucetRequest]) (*connect.Response[v1.FaucetResponse], error) {
+	return c.faucet.CallUnary(ctx, req)
+}
+
+// Upload calls localinterchain.v1.LocalInterchainService.Upload.
+func (c *localInterchainServiceClient) Upload(ctx context.Context) *connect.ClientStreamForClient[v1.UploadRequest, v1.UploadResponse] {
+	return c.upload.CallClientStream(ctx)
+}
+
+// StartRelayer calls localinterchain.v1.LocalInterchainService.StartRelayer.
+func (c *localInterchainServiceClient) StartRelayer(ctx context.Context, req *connect.Request[v1.StartRelayerRequest]) (*connect.Response[v1.StartRelayerResponse], error) {
+	return c.startRelayer.CallUnary(ctx, req)
+}
+
+// StopRelayer calls localinterchain.v1.LocalInterchainService.StopRelayer.
+func (c *localInterchainServiceClient) StopRelayer(ctx context.Context, req *connect.Request[v1.StopRelayerRequest]) (*connect.Response[v1.StopRelayerResponse], error) {
+	return c.stopRelayer.CallUnary(ctx, req)
+}
+
+// RelayerExec calls localinterchain.v1.LocalInterchainService.RelayerExec.
+func (c *localInterchainServiceClient) RelayerExec(ctx context.Context, req *connect.Request[v1.RelayerExecRequest]) (*connect.Response[v1.RelayerExecResponse], error) {
+	return c.relayerExec.CallUnary(ctx, req)
+}
+
+// GetChannels calls localinterchain.v1.LocalInterchainService.GetChannels.
+func (c *localInterchainServiceClient) GetChannels(ctx context.Context, req *connect.Request[v1.GetChannelsRequest]) (*connect.Response[v1.GetChannelsResponse], error) {
+	return c.getChannels.CallUnary(ctx, req)
+}
+
+// StreamBlocks calls localinterchain.v1.LocalInterchainService.StreamBlocks.
+func (c *localInterchainServiceClient) StreamBlocks(ctx context.Context, req *connect.Request[v1.StreamBlocksRequest]) (*connect.ServerStreamForClient[v1.StreamBlocksResponse], error) {
+	return c.streamBlocks.CallServerStream(ctx, req)
+}
+
+// StreamLogs calls localinterchain.v1.LocalInterchainService.StreamLogs.
+func (c *localInterchainServiceClient) StreamLogs(ctx context.Context, req *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error) {
+	return c.streamLogs.CallServerStream(ctx, req)
+}
+
+// LocalInterchainServiceHandler is an implementation of the
+// localinterchain.v1.LocalInterchainService service.
+type LocalInterchainServiceHandler interface {
+	// Info returns the chains and relayer of the network.
+	Info(context.Context, *connect.Request[v1.InfoRequest]) (*connect.Response[v1.InfoResponse], error)
+	// Query runs a query command of the chain binary against a node.
+	Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error)
+	// Bin runs the chain binary in a node's container.
+	Bin(context.Context, *connect.Request[v1.BinRequest]) (*connect.Response[v1.BinResponse], error)
+	// Exec runs a command in a node's container.
+	Exec(context.Context, *connect.Request[v1.ExecRequest]) (*connect.Response[v1.ExecResponse], error)
+	// Faucet sends funds of the chain's denom from the faucet account.
+	Faucet(context.Context, *connect.Request[v1.FaucetRequest]) (*connect.Response[v1.FaucetResponse], error)
+	// Upload copies a file into a node's home directory, or stores it as a CosmWasm contract.
+	// The first message holds the metadata of the upload, the following ones the content of the file.
+	Upload(context.Context, *connect.ClientStream[v1.UploadRequest]) (*connect.Response[v1.UploadResponse], error)
+	// StartRelayer starts the relayer, on every path if none is given.
+	StartRelayer(context.Context, *connect.Request[v1.StartRelayerRequest]) (*connect.Response[v1.StartRelayerResponse], error)
+	// StopRelayer stops the relayer.
+	StopRelayer(context.Context, *connect.Request[v1.StopRelayerRequest]) (*connect.Response[v1.StopRelayerResponse], error)
+	// RelayerExec runs a relayer command.
+	RelayerExec(context.Context, *connect.Request[v1.RelayerExecRequest]) (*connect.Response[v1.RelayerExecResponse], error)
+	// GetChannels returns the IBC channels of a chain, as reported by the relayer.
+	GetChannels(context.Context, *connect.Request[v1.GetChannelsRequest]) (*connect.Response[v1.GetChannelsResponse], error)
+	// StreamBlocks streams the blocks of a chain as they are committed.
+	StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest], *connect.ServerStream[v1.StreamBlocksResponse]) error
+	// StreamLogs streams the container logs of a node, starting with lines written at the time of the call.
+	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest], *connect.ServerStream[v1.StreamLogsResponse]) error
+}
+
+// NewLocalInterchainServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLocalInterchainServiceHandler(svc LocalInterchainServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	localInterchainServiceInfoHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceInfoProcedure,
+		svc.Info,
+		connect.WithSchema(localInterchainServiceInfoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceQueryHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceQueryProcedure,
+		svc.Query,
+		connect.WithSchema(localInterchainServiceQueryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceBinHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceBinProcedure,
+		svc.Bin,
+		connect.WithSchema(localInterchainServiceBinMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceExecHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceExecProcedure,
+		svc.Exec,
+		connect.WithSchema(localInterchainServiceExecMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceFaucetHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceFaucetProcedure,
+		svc.Faucet,
+		connect.WithSchema(localInterchainServiceFaucetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceUploadHandler := connect.NewClientStreamHandler(
+		LocalInterchainServiceUploadProcedure,
+		svc.Upload,
+		connect.WithSchema(localInterchainServiceUploadMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceStartRelayerHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceStartRelayerProcedure,
+		svc.StartRelayer,
+		connect.WithSchema(localInterchainServiceStartRelayerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceStopRelayerHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceStopRelayerProcedure,
+		svc.StopRelayer,
+		connect.WithSchema(localInterchainServiceStopRelayerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceRelayerExecHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceRelayerExecProcedure,
+		svc.RelayerExec,
+		connect.WithSchema(localInterchainServiceRelayerExecMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceGetChannelsHandler := connect.NewUnaryHandler(
+		LocalInterchainServiceGetChannelsProcedure,
+		svc.GetChannels,
+		connect.WithSchema(localInterchainServiceGetChannelsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceStreamBlocksHandler := connect.NewServerStreamHandler(
+		LocalInterchainServiceStreamBlocksProcedure,
+		svc.StreamBlocks,
+		connect.WithSchema(localInterchainServiceStreamBlocksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	localInterchainServiceStreamLogsHandler := connect.NewServerStreamHandler(
+		LocalInterchainServiceStreamLogsProcedure,
+		svc.StreamLogs,
+		connect.WithSchema(localInterchainServiceStreamLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/localinterchain.v1.LocalInterchainService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LocalInterchainServiceInfoProcedure:
+			localInterchainServiceInfoHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceQueryProcedure:
+			localInterchainServiceQueryHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceBinProcedure:
+			localInterchainServiceBinHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceExecProcedure:
+			localInterchainServiceExecHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceFaucetProcedure:
+			localInterchainServiceFaucetHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceUploadProcedure:
+			localInterchainServiceUploadHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceStartRelayerProcedure:
+			localInterchainServiceStartRelayerHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceStopRelayerProcedure:
+			localInterchainServiceStopRelayerHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceRelayerExecProcedure:
+			localInterchainServiceRelayerExecHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceGetChannelsProcedure:
+			localInterchainServiceGetChannelsHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceStreamBlocksProcedure:
+			localInterchainServiceStreamBlocksHandler.ServeHTTP(w, r)
+		case LocalInterchainServiceStreamLogsProcedure:
+			localInterchainServiceStreamLogsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLocalInterchainServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLocalInterchainServiceHandler struct{}
+
+func (UnimplementedLocalInterchainServiceHandler) Info(context.Context, *connect.Request[v1.InfoRequest]) (*connect.Response[v1.InfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.Info is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.Query is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) Bin(context.Context, *connect.Request[v1.BinRequest]) (*connect.Response[v1.BinResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.Bin is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) Exec(context.Context, *connect.Request[v1.ExecRequest]) (*connect.Response[v1.ExecResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.Exec is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) Faucet(context.Context, *connect.Request[v1.FaucetRequest]) (*connect.Response[v1.FaucetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.Faucet is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) Upload(context.Context, *connect.ClientStream[v1.UploadRequest]) (*connect.Response[v1.UploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.Upload is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) StartRelayer(context.Context, *connect.Request[v1.StartRelayerRequest]) (*connect.Response[v1.StartRelayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.StartRelayer is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) StopRelayer(context.Context, *connect.Request[v1.StopRelayerRequest]) (*connect.Response[v1.StopRelayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.StopRelayer is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) RelayerExec(context.Context, *connect.Request[v1.RelayerExecRequest]) (*connect.Response[v1.RelayerExecResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.RelayerExec is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) GetChannels(context.Context, *connect.Request[v1.GetChannelsRequest]) (*connect.Response[v1.GetChannelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.GetChannels is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest], *connect.ServerStream[v1.StreamBlocksResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.StreamBlocks is not implemented"))
+}
+
+func (UnimplementedLocalInterchainServiceHandler) StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest], *connect.ServerStream[v1.StreamLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("localinterchain.v1.LocalInterchainService.StreamLogs is not implemented"))
+}
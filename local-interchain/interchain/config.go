@@ -82,13 +82,7 @@ func setConfigDefaults(config *types.Config) *types.Config {
 	chains := config.Chains
 
 	for i := range chains {
-		chain := chains[i]
-		chain.SetChainDefaults()
-		util.ReplaceStringValues(&chain, "%DENOM%", chain.Denom)
-		util.ReplaceStringValues(&chain, "%BIN%", chain.Binary)
-		util.ReplaceStringValues(&chain, "%CHAIN_ID%", chain.ChainID)
-
-		chains[i] = chain
+		chains[i] = chainWithDefaults(chains[i])
 
 		if config.Chains[i].Debugging {
 			fmt.Printf("Loaded %v\n", config)
@@ -98,6 +92,15 @@ func setConfigDefaults(config *types.Config) *types.Config {
 	return config
 }
 
+// chainWithDefaults returns the chain with its defaults set and the %DENOM%, %BIN% and %CHAIN_ID% placeholders replaced.
+func chainWithDefaults(chain types.Chain) types.Chain {
+	chain.SetChainDefaults()
+	util.ReplaceStringValues(&chain, "%DENOM%", chain.Denom)
+	util.ReplaceStringValues(&chain, "%BIN%", chain.Binary)
+	util.ReplaceStringValues(&chain, "%CHAIN_ID%", chain.ChainID)
+	return chain
+}
+
 // ConfigurationOverrides creates a map of config file overrides for filenames, their keys, and values.
 func ConfigurationOverrides(cfg types.Chain) map[string]any {
 	var toml map[string]any
@@ -17,15 +17,16 @@ import (
 )
 
 type actions struct {
-	ctx  context.Context
-	ic   *interchaintest.Interchain
-	vals map[string][]*cosmos.ChainNode
-	cc   map[string]*cosmos.CosmosChain
+	ctx    context.Context
+	ic     *interchaintest.Interchain
+	vals   map[string][]*cosmos.ChainNode
+	chains map[string]ibc.Chain
 
 	relayer ibc.Relayer
 	eRep    ibc.RelayerExecReporter
 
-	authKey string
+	authKey  string
+	shutdown func(persist bool)
 }
 
 type ActionHandler struct {
@@ -38,18 +39,19 @@ type ActionHandler struct {
 
 func NewActions(
 	ctx context.Context, ic *interchaintest.Interchain,
-	cosmosChains map[string]*cosmos.CosmosChain, vals map[string][]*cosmos.ChainNode,
+	chains map[string]ibc.Chain, vals map[string][]*cosmos.ChainNode,
 	relayer ibc.Relayer, eRep ibc.RelayerExecReporter,
-	authKey string,
+	authKey string, shutdown func(persist bool),
 ) *actions {
 	return &actions{
-		ctx:     ctx,
-		ic:      ic,
-		vals:    vals,
-		cc:      cosmosChains,
-		relayer: relayer,
-		eRep:    eRep,
-		authKey: authKey,
+		ctx:      ctx,
+		ic:       ic,
+		vals:     vals,
+		chains:   chains,
+		relayer:  relayer,
+		eRep:     eRep,
+		authKey:  authKey,
+		shutdown: shutdown,
 	}
 }
 
@@ -68,26 +70,30 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 
 	action := ah.Action
 	if action == "kill-all" {
-		KillAll(a.ctx, a.ic, a.vals, a.relayer, a.eRep)
+		// The relayer and nodes are stopped by the cleanup of the network, once the response is written.
+		util.Write(w, []byte("{}"))
+		a.shutdown(false)
 		return
 	}
 
 	chainId := ah.ChainId
-	if _, ok := a.vals[chainId]; !ok {
-		util.Write(w, []byte(fmt.Sprintf(`{"error":"chain_id '%s' not found. Chains %v"}`, chainId, a.vals[chainId])))
+	nodes, ok := chainNodes(a.chains, a.vals, chainId)
+	if !ok {
+		util.Write(w, []byte(fmt.Sprintf(`{"error":"chain_id '%s' not found. Chains %v"}`, chainId, chainIDs(a.chains))))
 		return
 	}
 
-	if len(a.vals[chainId]) <= ah.NodeIndex {
-		util.Write(w, []byte(fmt.Sprintf(`{"error":"node_index '%d' not found. nodes: %v"}`, ah.NodeIndex, len(a.vals[chainId]))))
+	if len(nodes) <= ah.NodeIndex {
+		util.Write(w, []byte(fmt.Sprintf(`{"error":"node_index '%d' not found. nodes: %v"}`, ah.NodeIndex, len(nodes))))
 		return
 	}
 
-	val := a.vals[chainId][ah.NodeIndex]
+	// Other chain types than cosmos support the actions of their type only, see ChainActions.
+	n := nodes[ah.NodeIndex]
 
-	ah.Cmd = strings.ReplaceAll(ah.Cmd, "%RPC%", fmt.Sprintf("tcp://%s:26657", val.HostName()))
+	ah.Cmd = strings.ReplaceAll(ah.Cmd, "%RPC%", n.rpcAddress())
 	ah.Cmd = strings.ReplaceAll(ah.Cmd, "%CHAIN_ID%", ah.ChainId)
-	ah.Cmd = strings.ReplaceAll(ah.Cmd, "%HOME%", val.HomeDir())
+	ah.Cmd = strings.ReplaceAll(ah.Cmd, "%HOME%", n.HomeDir())
 
 	cmd := strings.Split(ah.Cmd, " ")
 
@@ -107,12 +113,18 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 	// Node / Docker Linux Actions
 	switch action {
 	case "q", "query":
-		stdout, stderr, err = val.ExecQuery(a.ctx, cmd...)
+		stdout, stderr, err = n.Query(a.ctx, cmd)
 	case "b", "bin", "binary":
-		stdout, stderr, err = val.ExecBin(a.ctx, cmd...)
+		stdout, stderr, err = n.Bin(a.ctx, cmd)
 	case "e", "exec", "execute":
-		stdout, stderr, err = val.Exec(a.ctx, cmd, []string{})
+		stdout, stderr, err = n.Exec(a.ctx, cmd)
 	case "recover-key":
+		val, err := n.cosmosNode(ActionRecoverKey)
+		if err != nil {
+			util.WriteError(w, err)
+			return
+		}
+
 		kn := cmdMap["keyname"]
 		if err := val.RecoverKey(a.ctx, kn, cmdMap["mnemonic"]); err != nil {
 			if !strings.Contains(err.Error(), "aborted") {
@@ -122,13 +134,23 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 		}
 		stdout = []byte(fmt.Sprintf(`{"recovered_key":"%s"}`, kn))
 	case "overwrite-genesis-file":
+		val, err := n.cosmosNode(ActionOverwriteGenesis)
+		if err != nil {
+			util.WriteError(w, err)
+			return
+		}
+
 		if err := val.OverwriteGenesisFile(a.ctx, []byte(cmdMap["new_genesis"])); err != nil {
 			util.WriteError(w, fmt.Errorf("failed to override genesis file: %s", err))
 			return
 		}
 		stdout = []byte(fmt.Sprintf(`{"overwrote_genesis_file":"%s"}`, val.ContainerID()))
 	case "add-full-nodes":
-		chain := a.cc[chainId]
+		if _, err := n.cosmosNode(ActionAddFullNodes); err != nil {
+			util.WriteError(w, err)
+			return
+		}
+		chain := n.chain.(*cosmos.CosmosChain)
 
 		amt, err := strconv.Atoi(cmdMap["amount"])
 		if err != nil {
@@ -143,9 +165,15 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 
 		stdout = []byte(fmt.Sprintf(`{"added_full_node":"%s"}`, cmdMap["amount"]))
 	case "dump-contract-state":
+		val, err := n.cosmosNode(ActionDumpContractState)
+		if err != nil {
+			util.WriteError(w, err)
+			return
+		}
+
 		stdout = dumpContractState(r, cmdMap, a, val)
 	case "faucet":
-		stdout = faucet(r, cmdMap, a.ctx, a, val)
+		stdout = faucet(r, cmdMap, a.ctx, a, n)
 	}
 
 	// Relayer Actions if the above is not used.
@@ -165,12 +193,7 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 			err = a.relayer.StartRelayer(a.ctx, a.eRep, paths...)
 
 		case "relayer", "relayer-exec", "relayer_exec", "relayerExec":
-			if !strings.Contains(ah.Cmd, "--home") {
-				// does this ever change for any other relayer?
-				cmd = append(cmd, "--home", "/home/relayer")
-			}
-
-			res := a.relayer.Exec(a.ctx, a.eRep, cmd, []string{})
+			res := a.relayer.Exec(a.ctx, a.eRep, relayerCommand(a.relayer, cmd), []string{})
 			stdout = []byte(res.Stdout)
 			stderr = []byte(res.Stderr)
 			err = res.Err
@@ -216,23 +239,6 @@ func (a *actions) relayerCheck(w http.ResponseWriter, r *http.Request) error {
 	return err
 }
 
-func KillAll(ctx context.Context, ic *interchaintest.Interchain, vals map[string][]*cosmos.ChainNode, relayer ibc.Relayer, eRep ibc.RelayerExecReporter) {
-	if relayer != nil {
-		if err := relayer.StopRelayer(ctx, eRep); err != nil {
-			panic(err)
-		}
-	}
-
-	for _, v := range vals {
-		for _, c := range v {
-			go c.StopContainer(ctx) // nolint:errcheck
-		}
-	}
-
-	ic.Close()
-	<-ctx.Done()
-}
-
 func dumpContractState(r *http.Request, cmdMap map[string]string, a *actions, val *cosmos.ChainNode) []byte {
 	contract, ok1 := cmdMap["contract"]
 	height, ok2 := cmdMap["height"]
@@ -258,7 +264,7 @@ func dumpContractState(r *http.Request, cmdMap map[string]string, a *actions, va
 	return jsonRes
 }
 
-func faucet(r *http.Request, cmdMap map[string]string, ctx context.Context, a *actions, val *cosmos.ChainNode) []byte {
+func faucet(r *http.Request, cmdMap map[string]string, ctx context.Context, a *actions, n node) []byte {
 	amount, ok1 := cmdMap["amount"]
 	toAddr, ok2 := cmdMap["address"]
 
@@ -271,11 +277,7 @@ func faucet(r *http.Request, cmdMap map[string]string, ctx context.Context, a *a
 		return []byte(fmt.Sprintf(`{"error":"failed to convert amount to int: %s"}`, amount))
 	}
 
-	if err := val.BankSend(ctx, "faucet", ibc.WalletAmount{
-		Address: toAddr,
-		Amount:  amt,
-		Denom:   val.Chain.Config().Denom,
-	}); err != nil {
+	if err := n.Faucet(ctx, toAddr, amt); err != nil {
 		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
 	}
 
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostActions_KillAll(t *testing.T) {
+	shutdowns := 0
+	var persisted bool
+	a := NewActions(context.Background(), nil, nil, nil, nil, nil, "secret", func(persist bool) {
+		shutdowns++
+		persisted = persist
+	})
+
+	do := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		a.PostActions(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		return w
+	}
+
+	w := do(`{"action": "kill-all"}`)
+	require.Contains(t, w.Body.String(), "invalid `auth_key`")
+	require.Zero(t, shutdowns)
+
+	w = do(`{"action": "kill-all", "auth_key": "secret"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "{}", w.Body.String())
+	require.Equal(t, 1, shutdowns)
+	require.False(t, persisted)
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// Actions of the nodes of a chain, listed for each chain in the info and v2 chain responses.
+const (
+	ActionQuery             = "query"
+	ActionBin               = "bin"
+	ActionExec              = "exec"
+	ActionFaucet            = "faucet"
+	ActionUpload            = "upload"
+	ActionRecoverKey        = "recover-key"
+	ActionStoreContract     = "store-contract"
+	ActionDumpContractState = "dump-contract-state"
+	ActionOverwriteGenesis  = "overwrite-genesis-file"
+	ActionAddFullNodes      = "add-full-nodes"
+	ActionStreamBlocks      = "stream-blocks"
+	ActionStreamLogs        = "stream-logs"
+)
+
+// chainTypeActions are the actions supported by each chain type.
+// Chain types not listed support defaultChainActions, which only need ibc.Chain.
+var chainTypeActions = map[string][]string{
+	"cosmos": {
+		ActionQuery, ActionBin, ActionExec, ActionFaucet, ActionUpload, ActionRecoverKey, ActionStoreContract,
+		ActionDumpContractState, ActionOverwriteGenesis, ActionAddFullNodes, ActionStreamBlocks, ActionStreamLogs,
+	},
+	"ethereum": {ActionQuery, ActionExec, ActionFaucet, ActionUpload},
+}
+
+var defaultChainActions = []string{ActionExec, ActionFaucet}
+
+var errUnsupported = errors.New("not supported")
+
+// ChainActions returns the actions supported by the chain type.
+func ChainActions(chainType string) []string {
+	if actions, ok := chainTypeActions[chainType]; ok {
+		return actions
+	}
+	return defaultChainActions
+}
+
+// chainsMu guards the chains and validators maps and the chains of the config shared by the handlers,
+// which RegisterChain and RegisterPath change while the network runs.
+var chainsMu sync.RWMutex
+
+// Network changes the chains and paths of the running network.
+type Network interface {
+	// AddChain starts the chain on the docker network of the running chains and registers it with RegisterChain.
+	// The IBC paths of the chain are then linked to the running chains sharing them.
+	AddChain(ctx context.Context, chain types.Chain) error
+	// AddPath links the path between two running chains, registers it with RegisterPath and restarts the relayer on every path.
+	AddPath(ctx context.Context, path string, chainIDs [2]string) error
+}
+
+// RegisterChain adds the chain to the config and to the chains and validators maps of the handlers.
+// The IBC paths of chainCfg are dropped, paths are registered with RegisterPath once linked.
+func RegisterChain(cfg *types.Config, chains map[string]ibc.Chain, vals map[string][]*cosmos.ChainNode, chainCfg types.Chain, chain ibc.Chain) {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	chainCfg.IBCPaths = []string{}
+	cfg.Chains = append(cfg.Chains, chainCfg)
+	chains[chainCfg.ChainID] = chain
+	if cc, ok := chain.(*cosmos.CosmosChain); ok {
+		vals[chainCfg.ChainID] = cc.Validators
+	}
+}
+
+// RegisterPath adds the path to the IBC paths of both chains in the config, unless a chain lists it already.
+func RegisterPath(cfg *types.Config, path string, chainIDs [2]string) {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	for i, c := range cfg.Chains {
+		if c.ChainID != chainIDs[0] && c.ChainID != chainIDs[1] {
+			continue
+		}
+		if slices.Contains(c.IBCPaths, path) {
+			continue
+		}
+		cfg.Chains[i].IBCPaths = append(append([]string{}, c.IBCPaths...), path)
+	}
+}
+
+// configChains returns a copy of the chains of the config.
+func configChains(cfg *types.Config) []types.Chain {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+	return append([]types.Chain{}, cfg.Chains...)
+}
+
+// ibcPaths returns the IDs of the chains of each IBC path of the config.
+func ibcPaths(cfg *types.Config) map[string][]string {
+	paths := make(map[string][]string)
+	for _, c := range configChains(cfg) {
+		for _, p := range c.IBCPaths {
+			paths[p] = append(paths[p], c.ChainID)
+		}
+	}
+	return paths
+}
+
+// chainIDs returns the sorted IDs of the chains.
+func chainIDs(chains map[string]ibc.Chain) []string {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	ids := make([]string, 0, len(chains))
+	for id := range chains {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// node is a node of a chain of any type. Cosmos chains have a node for each validator,
+// other chain types a single node backed by the chain itself.
+type node struct {
+	chain ibc.Chain
+	// val is set for nodes of cosmos chains.
+	val *cosmos.ChainNode
+}
+
+// chainNodes returns the nodes of the chain_id, and whether the chain exists.
+func chainNodes(chains map[string]ibc.Chain, vals map[string][]*cosmos.ChainNode, chainID string) ([]node, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	if vs, ok := vals[chainID]; ok {
+		nodes := make([]node, len(vs))
+		for i, v := range vs {
+			nodes[i] = node{chain: v.Chain, val: v}
+		}
+		return nodes, true
+	}
+
+	chain, ok := chains[chainID]
+	if !ok {
+		return nil, false
+	}
+	return []node{{chain: chain}}, true
+}
+
+// supports returns an error wrapping errUnsupported unless the chain type of the node supports action.
+func (n node) supports(action string) error {
+	chainType := n.chain.Config().Type
+	for _, a := range ChainActions(chainType) {
+		if a == action {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is %w on %s chains", action, errUnsupported, chainType)
+}
+
+func (n node) Name() string {
+	if n.val != nil {
+		return n.val.Name()
+	}
+	if c, ok := n.chain.(interface{ Name() string }); ok {
+		return c.Name()
+	}
+	return n.chain.Config().Name
+}
+
+func (n node) ContainerID() string {
+	if n.val != nil {
+		return n.val.ContainerID()
+	}
+	if c, ok := n.chain.(interface{ ContainerID() string }); ok {
+		return c.ContainerID()
+	}
+	return ""
+}
+
+func (n node) HostName() string {
+	if n.val != nil {
+		return n.val.HostName()
+	}
+	if c, ok := n.chain.(interface{ HostName() string }); ok {
+		return c.HostName()
+	}
+	return ""
+}
+
+func (n node) HomeDir() string {
+	if n.val != nil {
+		return n.val.HomeDir()
+	}
+	return n.chain.HomeDir()
+}
+
+func (n node) Height(ctx context.Context) (int64, error) {
+	if n.val != nil {
+		return n.val.Height(ctx)
+	}
+	return n.chain.Height(ctx)
+}
+
+// rpcAddress returns the RPC address of the node within the docker network.
+func (n node) rpcAddress() string {
+	if n.val != nil {
+		return fmt.Sprintf("tcp://%s:26657", n.val.HostName())
+	}
+	return n.chain.GetRPCAddress()
+}
+
+// replaceVars replaces the %RPC%, %CHAIN_ID% and %HOME% placeholders of args with the values of the node.
+func (n node) replaceVars(args []string) []string {
+	replaced := make([]string, len(args))
+	for i, arg := range args {
+		arg = strings.ReplaceAll(arg, "%RPC%", n.rpcAddress())
+		arg = strings.ReplaceAll(arg, "%CHAIN_ID%", n.chain.Config().ChainID)
+		replaced[i] = strings.ReplaceAll(arg, "%HOME%", n.HomeDir())
+	}
+	return replaced
+}
+
+// Exec runs cmd in a container of the node.
+func (n node) Exec(ctx context.Context, cmd []string) ([]byte, []byte, error) {
+	if n.val != nil {
+		return n.val.Exec(ctx, cmd, []string{})
+	}
+	return n.chain.Exec(ctx, cmd, []string{})
+}
+
+// Query runs a query against the node: the chain binary's query command on cosmos chains,
+// and cast with the RPC of the node on ethereum chains.
+func (n node) Query(ctx context.Context, args []string) ([]byte, []byte, error) {
+	if err := n.supports(ActionQuery); err != nil {
+		return nil, nil, err
+	}
+	if n.val != nil {
+		return n.val.ExecQuery(ctx, args...)
+	}
+	cmd := append([]string{"cast"}, args...)
+	return n.chain.Exec(ctx, append(cmd, "--rpc-url", n.rpcAddress()), []string{})
+}
+
+// Bin runs the chain binary with args.
+func (n node) Bin(ctx context.Context, args []string) ([]byte, []byte, error) {
+	val, err := n.cosmosNode(ActionBin)
+	if err != nil {
+		return nil, nil, err
+	}
+	return val.ExecBin(ctx, args...)
+}
+
+// Faucet sends amount of the chain denom from the faucet account to address.
+func (n node) Faucet(ctx context.Context, address string, amount sdkmath.Int) error {
+	wa := ibc.WalletAmount{
+		Address: address,
+		Amount:  amount,
+		Denom:   n.chain.Config().Denom,
+	}
+	if n.val != nil {
+		return n.val.BankSend(ctx, "faucet", wa)
+	}
+	return n.chain.SendFunds(ctx, "faucet", wa)
+}
+
+// WriteFile writes content to relPath, relative to the home directory of the node.
+func (n node) WriteFile(ctx context.Context, content []byte, relPath string) error {
+	if err := n.supports(ActionUpload); err != nil {
+		return err
+	}
+	if n.val != nil {
+		return n.val.WriteFile(ctx, content, relPath)
+	}
+	fw, ok := n.chain.(interface {
+		WriteFile(ctx context.Context, content []byte, relPath string) error
+	})
+	if !ok {
+		return fmt.Errorf("%s is %w on %s chains", ActionUpload, errUnsupported, n.chain.Config().Type)
+	}
+	return fw.WriteFile(ctx, content, relPath)
+}
+
+// cosmosNode returns the cosmos node backing n, or an error wrapping errUnsupported if the chain type does not support action.
+func (n node) cosmosNode(action string) (*cosmos.ChainNode, error) {
+	if err := n.supports(action); err != nil {
+		return nil, err
+	}
+	if n.val == nil {
+		return nil, fmt.Errorf("%s is %w on %s chains", action, errUnsupported, n.chain.Config().Type)
+	}
+	return n.val, nil
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// fakeEthereum is an ethereum chain recording its commands and transfers.
+// Methods the handlers do not use panic through the nil ibc.Chain.
+type fakeEthereum struct {
+	ibc.Chain
+
+	cmds  [][]string
+	funds []ibc.WalletAmount
+}
+
+func (c *fakeEthereum) Config() ibc.ChainConfig {
+	return ibc.ChainConfig{Type: "ethereum", Name: "ethereum", ChainID: "31337", Denom: "wei"}
+}
+
+func (c *fakeEthereum) HomeDir() string { return "/home/foundry" }
+
+func (c *fakeEthereum) GetRPCAddress() string { return "http://anvil:8545" }
+
+func (c *fakeEthereum) Height(ctx context.Context) (int64, error) { return 10, nil }
+
+func (c *fakeEthereum) Exec(ctx context.Context, cmd []string, env []string) ([]byte, []byte, error) {
+	c.cmds = append(c.cmds, cmd)
+	return []byte(`"ok"`), nil, nil
+}
+
+func (c *fakeEthereum) SendFunds(ctx context.Context, keyName string, amount ibc.WalletAmount) error {
+	c.funds = append(c.funds, amount)
+	return nil
+}
+
+func TestChainTypes(t *testing.T) {
+	eth := &fakeEthereum{}
+	cfg := &types.Config{Chains: []types.Chain{{ChainID: "31337"}}}
+	chains := map[string]ibc.Chain{"31337": eth}
+
+	r := mux.NewRouter()
+	NewV2(context.Background(), nil, cfg, chains, nil, nil, nil, nil, "", nil).Register(r)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return w
+	}
+
+	t.Run("chain", func(t *testing.T) {
+		w := do(http.MethodGet, "/v2/chains/31337", "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var res ChainResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, 1, res.Nodes)
+		require.Equal(t, ChainActions("ethereum"), res.Actions)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		w := do(http.MethodPost, "/v2/chains/31337/nodes/0/query", `{"args": ["balance", "%HOME%"]}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, []string{"cast", "balance", "/home/foundry", "--rpc-url", "http://anvil:8545"}, eth.cmds[len(eth.cmds)-1])
+	})
+
+	t.Run("exec", func(t *testing.T) {
+		w := do(http.MethodPost, "/v2/chains/31337/nodes/0/exec", `{"args": ["ls", "%RPC%"]}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, []string{"ls", "http://anvil:8545"}, eth.cmds[len(eth.cmds)-1])
+	})
+
+	t.Run("faucet", func(t *testing.T) {
+		w := do(http.MethodPost, "/v2/chains/31337/faucet", `{"address": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "amount": "100"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, eth.funds, 1)
+		require.Equal(t, "wei", eth.funds[0].Denom)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		require.Equal(t, http.StatusNotImplemented, do(http.MethodPost, "/v2/chains/31337/nodes/0/bin", `{"args": ["version"]}`).Code)
+		require.Equal(t, http.StatusNotImplemented, do(http.MethodPost, "/v2/chains/31337/wallets", `{"name": "a", "mnemonic": "b"}`).Code)
+	})
+
+	t.Run("default actions", func(t *testing.T) {
+		require.Equal(t, defaultChainActions, ChainActions("polkadot"))
+	})
+}
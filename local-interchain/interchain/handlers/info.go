@@ -28,7 +28,7 @@ type info struct {
 	relayer ibc.Relayer
 	eRep    ibc.RelayerExecReporter
 
-	cc map[string]*cosmos.CosmosChain
+	chains map[string]ibc.Chain
 
 	chainId string
 }
@@ -38,7 +38,7 @@ func NewInfo(
 	installDir string,
 	ctx context.Context,
 	ic *interchaintest.Interchain,
-	cosmosChains map[string]*cosmos.CosmosChain,
+	chains map[string]ibc.Chain,
 	vals map[string][]*cosmos.ChainNode,
 	relayer ibc.Relayer,
 	eRep ibc.RelayerExecReporter,
@@ -50,12 +50,23 @@ func NewInfo(
 		ctx:     ctx,
 		ic:      ic,
 		vals:    vals,
-		cc:      cosmosChains,
+		chains:  chains,
 		relayer: relayer,
 		eRep:    eRep,
 	}
 }
 
+// chainRequests are the info requests supported by chains of every type.
+var chainRequests = map[string]bool{
+	"logs":         true,
+	"config":       true,
+	"name":         true,
+	"container_id": true,
+	"hostname":     true,
+	"home_dir":     true,
+	"height":       true,
+}
+
 type GetInfo struct {
 	Logs   types.MainLogs `json:"logs" yaml:"logs"`
 	Chains []types.Chain  `json:"chains" yaml:"chains"`
@@ -89,26 +100,34 @@ func (i *info) GetInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(i.vals[i.chainId]) <= idx {
-		util.WriteError(w, fmt.Errorf("node_index '%d' not found. nodes: %v", idx, len(i.vals[i.chainId])))
+	nodes, _ := chainNodes(i.chains, i.vals, i.chainId)
+	if len(nodes) <= idx {
+		util.WriteError(w, fmt.Errorf("node_index '%d' not found. nodes: %v", idx, len(nodes)))
 		return
 	}
 
-	val := i.vals[i.chainId][idx]
+	n := nodes[idx]
+	val := n.val
+
+	// The other requests read cosmos node state.
+	if val == nil && !chainRequests[res[0]] {
+		util.WriteError(w, fmt.Errorf("request %s is %w on %s chains", res[0], errUnsupported, n.chain.Config().Type))
+		return
+	}
 
 	switch res[0] {
 	case "logs":
 		get_logs(w, r, i)
 	case "config":
-		config(w, r, val)
+		config(w, r, n.chain)
 	case "name":
-		util.Write(w, []byte(val.Name()))
+		util.Write(w, []byte(n.Name()))
 	case "container_id":
-		util.Write(w, []byte(val.ContainerID()))
+		util.Write(w, []byte(n.ContainerID()))
 	case "hostname":
-		util.Write(w, []byte(val.HostName()))
+		util.Write(w, []byte(n.HostName()))
 	case "home_dir":
-		util.Write(w, []byte(val.HomeDir()))
+		util.Write(w, []byte(n.HomeDir()))
 	case "is_above_sdk_47", "is_above_sdk_v47":
 		util.Write(w, []byte(strconv.FormatBool(val.IsAboveSDK47(i.ctx))))
 	case "has_command":
@@ -116,7 +135,7 @@ func (i *info) GetInfo(w http.ResponseWriter, r *http.Request) {
 	case "read_file":
 		readFile(w, r, form, i, val)
 	case "height":
-		height, _ := val.Height(i.ctx)
+		height, _ := n.Height(i.ctx)
 		util.Write(w, []byte(strconv.Itoa(int(height))))
 	case "build_information":
 		getBuildInfo(w, r, i, val)
@@ -130,8 +149,8 @@ func (i *info) GetInfo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func config(w http.ResponseWriter, r *http.Request, val *cosmos.ChainNode) {
-	cfg := val.Chain.Config()
+func config(w http.ResponseWriter, r *http.Request, chain ibc.Chain) {
+	cfg := chain.Config()
 	jsonRes, err := MarshalIBCChainConfig(cfg)
 	if err != nil {
 		util.WriteError(w, fmt.Errorf("failed to marshal config: %w", err))
@@ -193,7 +212,7 @@ func get_logs(w http.ResponseWriter, r *http.Request, i *info) {
 	}
 
 	// hide mnemonics from query
-	chains := i.Config.Chains
+	chains := configChains(i.Config)
 	for idx, chain := range chains {
 		updatedAccounts := []types.GenesisAccount{}
 
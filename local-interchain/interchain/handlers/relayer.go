@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/hermes"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/hyperspace"
+)
+
+// defaultRelayerHome is the home of relayers not exposing their own.
+const defaultRelayerHome = "/home/relayer"
+
+// relayerHome returns the home directory of the relayer container.
+func relayerHome(relayer ibc.Relayer) string {
+	if r, ok := relayer.(interface{ HomeDir() string }); ok {
+		return r.HomeDir()
+	}
+	return defaultRelayerHome
+}
+
+// relayerImage returns the docker image the relayer runs, falling back to the configured one.
+func relayerImage(relayer ibc.Relayer, cfg types.Relayer) string {
+	img := cfg.DockerImage
+	if r, ok := relayer.(interface{ ContainerImage() ibc.DockerImage }); ok {
+		img = r.ContainerImage()
+	}
+	return fmt.Sprintf("%s:%s", img.Repository, img.Version)
+}
+
+// relayerRunning reports whether the relayer runs on its paths. Relayers not reporting it are assumed stopped.
+func relayerRunning(ctx context.Context, relayer ibc.Relayer) bool {
+	if r, ok := relayer.(interface{ Running(context.Context) bool }); ok {
+		return r.Running(ctx)
+	}
+	return false
+}
+
+// relayerCommand points args at the home of the relayer, unless they already do.
+// %HOME% is replaced with the relayer home, which hyperspace commands need for their per-chain config files.
+//   - rly: --home <home> is appended.
+//   - hermes: --config <its config file> is set after the binary, as hermes only accepts it there.
+//   - hyperspace: args are left as they are, hyperspace takes its configs per command.
+func relayerCommand(relayer ibc.Relayer, args []string) []string {
+	home := relayerHome(relayer)
+
+	cmd := make([]string, len(args))
+	for i, arg := range args {
+		cmd[i] = strings.ReplaceAll(arg, "%HOME%", home)
+	}
+
+	switch r := relayer.(type) {
+	case *hermes.Relayer:
+		if len(cmd) == 0 || containsFlag(cmd, "--config") {
+			return cmd
+		}
+		return append([]string{cmd[0], "--config", r.ConfigPath()}, cmd[1:]...)
+	case *hyperspace.HyperspaceRelayer:
+		return cmd
+	default:
+		if containsFlag(cmd, "--home") {
+			return cmd
+		}
+		return append(cmd, "--home", home)
+	}
+}
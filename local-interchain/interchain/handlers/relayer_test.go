@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/hermes"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/hyperspace"
+)
+
+// dockerRelayer returns a relayer with the given home, without a docker client.
+func dockerRelayer(home string) *relayer.DockerRelayer {
+	r := &relayer.DockerRelayer{}
+	relayer.HomeDir(home)(r)
+	return r
+}
+
+func TestRelayerCommand(t *testing.T) {
+	require.Equal(t, []string{"rly", "paths", "list", "--home", "/home/relayer"}, relayerCommand(nil, []string{"rly", "paths", "list"}))
+	require.Equal(t, []string{"rly", "paths", "list", "--home=/tmp"}, relayerCommand(nil, []string{"rly", "paths", "list", "--home=/tmp"}))
+	require.Equal(t, []string{"cat", "/home/relayer/config/config.yaml", "--home", "/home/relayer"}, relayerCommand(nil, []string{"cat", "%HOME%/config/config.yaml"}))
+
+	h := &hermes.Relayer{DockerRelayer: dockerRelayer("/home/hermes")}
+	require.Equal(t, []string{"hermes", "--config", "/home/hermes/.hermes/config.toml", "query", "channels", "--chain", "localjuno-1"},
+		relayerCommand(h, []string{"hermes", "query", "channels", "--chain", "localjuno-1"}))
+	require.Equal(t, []string{"hermes", "--config", "/tmp/config.toml", "version"}, relayerCommand(h, []string{"hermes", "--config", "/tmp/config.toml", "version"}))
+	require.Equal(t, []string{"hermes", "--config=/tmp/config.toml", "version"}, relayerCommand(h, []string{"hermes", "--config=/tmp/config.toml", "version"}))
+	require.Empty(t, relayerCommand(h, nil))
+
+	hs := &hyperspace.HyperspaceRelayer{DockerRelayer: dockerRelayer("/home/hyperspace")}
+	require.Equal(t, []string{"hyperspace", "query", "--config-a", "/home/hyperspace/localjuno-1.config"},
+		relayerCommand(hs, []string{"hyperspace", "query", "--config-a", "%HOME%/localjuno-1.config"}))
+}
@@ -0,0 +1,450 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	sdkmath "cosmossdk.io/math"
+
+	localinterchainv1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1/localinterchainv1connect"
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// blockPollInterval is how often StreamBlocks checks for a new block.
+const blockPollInterval = 250 * time.Millisecond
+
+// publicProcedures do not require the auth key.
+var publicProcedures = map[string]bool{
+	localinterchainv1connect.LocalInterchainServiceInfoProcedure:         true,
+	localinterchainv1connect.LocalInterchainServiceGetChannelsProcedure:  true,
+	localinterchainv1connect.LocalInterchainServiceStreamBlocksProcedure: true,
+	localinterchainv1connect.LocalInterchainServiceStreamLogsProcedure:   true,
+}
+
+var _ localinterchainv1connect.LocalInterchainServiceHandler = (*rpc)(nil)
+
+type rpc struct {
+	ctx    context.Context
+	ic     *interchaintest.Interchain
+	cfg    *types.Config
+	chains map[string]ibc.Chain
+	vals   map[string][]*cosmos.ChainNode
+
+	relayer ibc.Relayer
+	eRep    ibc.RelayerExecReporter
+}
+
+func NewRPC(
+	ctx context.Context,
+	ic *interchaintest.Interchain,
+	cfg *types.Config,
+	chains map[string]ibc.Chain,
+	vals map[string][]*cosmos.ChainNode,
+	relayer ibc.Relayer,
+	eRep ibc.RelayerExecReporter,
+) *rpc {
+	return &rpc{
+		ctx:     ctx,
+		ic:      ic,
+		cfg:     cfg,
+		chains:  chains,
+		vals:    vals,
+		relayer: relayer,
+		eRep:    eRep,
+	}
+}
+
+func (s *rpc) Info(ctx context.Context, req *connect.Request[localinterchainv1.InfoRequest]) (*connect.Response[localinterchainv1.InfoResponse], error) {
+	res := &localinterchainv1.InfoResponse{}
+	for _, c := range configChains(s.cfg) {
+		nodes, ok := chainNodes(s.chains, s.vals, c.ChainID)
+		if !ok || len(nodes) == 0 {
+			continue
+		}
+
+		cfg := nodes[0].chain.Config()
+		chain := &localinterchainv1.Chain{
+			Type:           cfg.Type,
+			Name:           cfg.Name,
+			ChainId:        cfg.ChainID,
+			Bin:            cfg.Bin,
+			Bech32Prefix:   cfg.Bech32Prefix,
+			Denom:          cfg.Denom,
+			CoinType:       cfg.CoinType,
+			GasPrices:      cfg.GasPrices,
+			GasAdjustment:  cfg.GasAdjustment,
+			TrustingPeriod: cfg.TrustingPeriod,
+			Actions:        ChainActions(cfg.Type),
+		}
+		for i, n := range nodes {
+			height, _ := n.Height(ctx)
+			chain.Nodes = append(chain.Nodes, &localinterchainv1.Node{
+				Index:       uint32(i),
+				Name:        n.Name(),
+				ContainerId: n.ContainerID(),
+				Hostname:    n.HostName(),
+				HomeDir:     n.HomeDir(),
+				Height:      height,
+			})
+		}
+		res.Chains = append(res.Chains, chain)
+	}
+
+	for name, ids := range ibcPaths(s.cfg) {
+		res.Paths = append(res.Paths, &localinterchainv1.Path{Name: name, ChainIds: ids})
+	}
+	sort.Slice(res.Paths, func(i, j int) bool { return res.Paths[i].Name < res.Paths[j].Name })
+
+	if s.relayer != nil {
+		res.RelayerType = s.cfg.Relayer.Type
+		res.RelayerImage = relayerImage(s.relayer, s.cfg.Relayer)
+		res.RelayerRunning = relayerRunning(ctx, s.relayer)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *rpc) Query(ctx context.Context, req *connect.Request[localinterchainv1.QueryRequest]) (*connect.Response[localinterchainv1.QueryResponse], error) {
+	n, args, err := s.command(req.Msg.ChainId, req.Msg.NodeIndex, req.Msg.Args)
+	if err != nil {
+		return nil, err
+	}
+	stdout, stderr, err := n.Query(s.ctx, args)
+	if errors.Is(err, errUnsupported) {
+		return nil, connect.NewError(connect.CodeUnimplemented, err)
+	}
+	return connect.NewResponse(&localinterchainv1.QueryResponse{Output: commandOutput(stdout, stderr, err)}), nil
+}
+
+func (s *rpc) Bin(ctx context.Context, req *connect.Request[localinterchainv1.BinRequest]) (*connect.Response[localinterchainv1.BinResponse], error) {
+	n, args, err := s.command(req.Msg.ChainId, req.Msg.NodeIndex, req.Msg.Args)
+	if err != nil {
+		return nil, err
+	}
+	stdout, stderr, err := n.Bin(s.ctx, args)
+	if errors.Is(err, errUnsupported) {
+		return nil, connect.NewError(connect.CodeUnimplemented, err)
+	}
+	return connect.NewResponse(&localinterchainv1.BinResponse{Output: commandOutput(stdout, stderr, err)}), nil
+}
+
+func (s *rpc) Exec(ctx context.Context, req *connect.Request[localinterchainv1.ExecRequest]) (*connect.Response[localinterchainv1.ExecResponse], error) {
+	n, args, err := s.command(req.Msg.ChainId, req.Msg.NodeIndex, req.Msg.Args)
+	if err != nil {
+		return nil, err
+	}
+	stdout, stderr, err := n.Exec(s.ctx, args)
+	return connect.NewResponse(&localinterchainv1.ExecResponse{Output: commandOutput(stdout, stderr, err)}), nil
+}
+
+func (s *rpc) Faucet(ctx context.Context, req *connect.Request[localinterchainv1.FaucetRequest]) (*connect.Response[localinterchainv1.FaucetResponse], error) {
+	n, err := s.node(req.Msg.ChainId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	amt, ok := sdkmath.NewIntFromString(req.Msg.Amount)
+	if !ok || req.Msg.Address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a valid address and integer amount are required"))
+	}
+
+	if err := n.Faucet(s.ctx, req.Msg.Address, amt); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&localinterchainv1.FaucetResponse{}), nil
+}
+
+func (s *rpc) Upload(ctx context.Context, stream *connect.ClientStream[localinterchainv1.UploadRequest]) (*connect.Response[localinterchainv1.UploadResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing upload metadata"))
+	}
+	meta := stream.Msg().GetMetadata()
+	if meta == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the first message must hold the upload metadata"))
+	}
+
+	n, err := s.node(meta.ChainId, meta.NodeIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	file := filepath.Base(meta.FileName)
+	if file == "." || file == string(filepath.Separator) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid file_name %q", meta.FileName))
+	}
+
+	var content bytes.Buffer
+	for stream.Receive() {
+		content.Write(stream.Msg().GetChunk())
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+
+	res := &localinterchainv1.UploadResponse{Location: filepath.Join(n.HomeDir(), file)}
+	if meta.CosmwasmKeyName == "" {
+		if err := n.WriteFile(s.ctx, content.Bytes(), file); err != nil {
+			if errors.Is(err, errUnsupported) {
+				return nil, connect.NewError(connect.CodeUnimplemented, err)
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("writing file to docker volume: %w", err))
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	val, err := n.cosmosNode(ActionStoreContract)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, err)
+	}
+
+	// StoreContract copies the contract from the host, so stage it under its own name.
+	dir, err := os.MkdirTemp("", "local-ic-upload")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer os.RemoveAll(dir)
+
+	srcPath := filepath.Join(dir, file)
+	if err := os.WriteFile(srcPath, content.Bytes(), 0o600); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res.CodeId, err = val.StoreContract(s.ctx, meta.CosmwasmKeyName, srcPath)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *rpc) StartRelayer(ctx context.Context, req *connect.Request[localinterchainv1.StartRelayerRequest]) (*connect.Response[localinterchainv1.StartRelayerResponse], error) {
+	if s.relayer == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errRelayerNotConfigured)
+	}
+	if err := s.relayer.StartRelayer(s.ctx, s.eRep, req.Msg.Paths...); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&localinterchainv1.StartRelayerResponse{}), nil
+}
+
+func (s *rpc) StopRelayer(ctx context.Context, req *connect.Request[localinterchainv1.StopRelayerRequest]) (*connect.Response[localinterchainv1.StopRelayerResponse], error) {
+	if s.relayer == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errRelayerNotConfigured)
+	}
+	if err := s.relayer.StopRelayer(s.ctx, s.eRep); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&localinterchainv1.StopRelayerResponse{}), nil
+}
+
+func (s *rpc) RelayerExec(ctx context.Context, req *connect.Request[localinterchainv1.RelayerExecRequest]) (*connect.Response[localinterchainv1.RelayerExecResponse], error) {
+	if s.relayer == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errRelayerNotConfigured)
+	}
+	if len(req.Msg.Args) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("args must not be empty"))
+	}
+
+	res := s.relayer.Exec(s.ctx, s.eRep, relayerCommand(s.relayer, req.Msg.Args), []string{})
+	return connect.NewResponse(&localinterchainv1.RelayerExecResponse{Output: commandOutput(res.Stdout, res.Stderr, res.Err)}), nil
+}
+
+func (s *rpc) GetChannels(ctx context.Context, req *connect.Request[localinterchainv1.GetChannelsRequest]) (*connect.Response[localinterchainv1.GetChannelsResponse], error) {
+	if s.relayer == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errRelayerNotConfigured)
+	}
+	if _, ok := chainNodes(s.chains, s.vals, req.Msg.ChainId); !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("chain_id %q %w", req.Msg.ChainId, errNotFound))
+	}
+
+	channels, err := s.relayer.GetChannels(s.ctx, s.eRep, req.Msg.ChainId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &localinterchainv1.GetChannelsResponse{}
+	for _, c := range channels {
+		res.Channels = append(res.Channels, &localinterchainv1.Channel{
+			State:                 c.State,
+			Ordering:              c.Ordering,
+			PortId:                c.PortID,
+			ChannelId:             c.ChannelID,
+			ConnectionHops:        c.ConnectionHops,
+			Version:               c.Version,
+			CounterpartyPortId:    c.Counterparty.PortID,
+			CounterpartyChannelId: c.Counterparty.ChannelID,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *rpc) StreamBlocks(ctx context.Context, req *connect.Request[localinterchainv1.StreamBlocksRequest], stream *connect.ServerStream[localinterchainv1.StreamBlocksResponse]) error {
+	n, err := s.cosmosNode(req.Msg.ChainId, 0, ActionStreamBlocks)
+	if err != nil {
+		return err
+	}
+
+	last, err := n.Height(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		height, err := n.Height(ctx)
+		if err != nil {
+			continue
+		}
+		for ; last < height; last++ {
+			h := last + 1
+			res, err := n.Client.Block(ctx, &h)
+			if err != nil {
+				return connect.NewError(connect.CodeUnavailable, err)
+			}
+			txHashes := make([]string, len(res.Block.Txs))
+			for i, tx := range res.Block.Txs {
+				txHashes[i] = fmt.Sprintf("%X", tx.Hash())
+			}
+			if err := stream.Send(&localinterchainv1.StreamBlocksResponse{Block: &localinterchainv1.Block{
+				Height:          res.Block.Height,
+				Time:            res.Block.Time.Format(time.RFC3339Nano),
+				Hash:            res.BlockID.Hash.String(),
+				ProposerAddress: res.Block.ProposerAddress.String(),
+				NumTxs:          uint32(len(res.Block.Txs)),
+				TxHashes:        txHashes,
+			}}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *rpc) StreamLogs(ctx context.Context, req *connect.Request[localinterchainv1.StreamLogsRequest], stream *connect.ServerStream[localinterchainv1.StreamLogsResponse]) error {
+	n, err := s.cosmosNode(req.Msg.ChainId, req.Msg.NodeIndex, ActionStreamLogs)
+	if err != nil {
+		return err
+	}
+
+	logs, err := n.StreamLogs(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	for l := range logs {
+		if err := stream.Send(&localinterchainv1.StreamLogsResponse{Line: &localinterchainv1.LogLine{
+			Time:    l.Time.Format(time.RFC3339Nano),
+			Stream:  l.Stream,
+			Raw:     l.Raw,
+			Level:   l.Level,
+			Message: l.Message,
+		}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// node returns the node at the index of the chain, or a NotFound error.
+func (s *rpc) node(chainID string, idx uint32) (node, error) {
+	nodes, ok := chainNodes(s.chains, s.vals, chainID)
+	if !ok {
+		return node{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("chain_id %q %w", chainID, errNotFound))
+	}
+	if int(idx) >= len(nodes) {
+		return node{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("node_index %d %w, nodes: %d", idx, errNotFound, len(nodes)))
+	}
+	return nodes[idx], nil
+}
+
+// cosmosNode returns the cosmos node at the index of the chain, or an Unimplemented error if the chain type does not support action.
+func (s *rpc) cosmosNode(chainID string, idx uint32, action string) (*cosmos.ChainNode, error) {
+	n, err := s.node(chainID, idx)
+	if err != nil {
+		return nil, err
+	}
+	val, err := n.cosmosNode(action)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, err)
+	}
+	return val, nil
+}
+
+// command returns the node and the arguments, with placeholders replaced, of a command request.
+func (s *rpc) command(chainID string, idx uint32, args []string) (node, []string, error) {
+	n, err := s.node(chainID, idx)
+	if err != nil {
+		return node{}, nil, err
+	}
+	if len(args) == 0 {
+		return node{}, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("args must not be empty"))
+	}
+	return n, n.replaceVars(args), nil
+}
+
+func commandOutput(stdout, stderr []byte, err error) *localinterchainv1.CommandOutput {
+	out := &localinterchainv1.CommandOutput{
+		Stdout: string(stdout),
+		Stderr: string(stderr),
+	}
+	if err != nil {
+		out.Error = err.Error()
+	}
+	return out
+}
+
+// NewAuthInterceptor returns an interceptor requiring authKey, if set,
+// as an "Authorization: Bearer <key>" header on every procedure changing the network.
+func NewAuthInterceptor(authKey string) connect.Interceptor {
+	return &authInterceptor{authKey: authKey}
+}
+
+type authInterceptor struct {
+	authKey string
+}
+
+func (a *authInterceptor) check(procedure, header string) error {
+	if a.authKey == "" || publicProcedures[procedure] || header == "Bearer "+a.authKey {
+		return nil
+	}
+	return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or missing auth key"))
+}
+
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := a.check(req.Spec().Procedure, req.Header().Get("Authorization")); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := a.check(conn.Spec().Procedure, conn.RequestHeader().Get("Authorization")); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
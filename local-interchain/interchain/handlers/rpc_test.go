@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	localinterchainv1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1/localinterchainv1connect"
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+)
+
+func TestRPC(t *testing.T) {
+	ctx := context.Background()
+	cfg := &types.Config{
+		Chains: []types.Chain{
+			{ChainID: "localjuno-1", IBCPaths: []string{"juno-ibc-1"}},
+			{ChainID: "localjuno-2", IBCPaths: []string{"juno-ibc-1"}},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(localinterchainv1connect.NewLocalInterchainServiceHandler(
+		NewRPC(ctx, nil, cfg, nil, nil, nil, nil),
+		connect.WithInterceptors(NewAuthInterceptor("secret")),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client := localinterchainv1connect.NewLocalInterchainServiceClient(srv.Client(), srv.URL)
+
+	t.Run("info", func(t *testing.T) {
+		res, err := client.Info(ctx, connect.NewRequest(&localinterchainv1.InfoRequest{}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Paths, 1)
+		require.Equal(t, []string{"localjuno-1", "localjuno-2"}, res.Msg.Paths[0].ChainIds)
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		_, err := client.StopRelayer(ctx, connect.NewRequest(&localinterchainv1.StopRelayerRequest{}))
+		require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		req := connect.NewRequest(&localinterchainv1.StopRelayerRequest{})
+		req.Header().Set("Authorization", "Bearer secret")
+		_, err = client.StopRelayer(ctx, req)
+		require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		req := connect.NewRequest(&localinterchainv1.QueryRequest{ChainId: "unknown", Args: []string{"bank", "total"}})
+		req.Header().Set("Authorization", "Bearer secret")
+		_, err := client.Query(ctx, req)
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		stream, err := client.StreamLogs(ctx, connect.NewRequest(&localinterchainv1.StreamLogsRequest{ChainId: "unknown"}))
+		require.NoError(t, err)
+		require.False(t, stream.Receive())
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(stream.Err()))
+	})
+}
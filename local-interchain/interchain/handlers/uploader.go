@@ -10,11 +10,13 @@ import (
 
 	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/util"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 )
 
 type upload struct {
-	ctx  context.Context
-	vals map[string][]*cosmos.ChainNode
+	ctx    context.Context
+	chains map[string]ibc.Chain
+	vals   map[string][]*cosmos.ChainNode
 
 	authKey string
 }
@@ -29,9 +31,10 @@ type Uploader struct {
 	AuthKey string `json:"auth_key,omitempty"`
 }
 
-func NewUploader(ctx context.Context, vals map[string][]*cosmos.ChainNode, authKey string) *upload {
+func NewUploader(ctx context.Context, chains map[string]ibc.Chain, vals map[string][]*cosmos.ChainNode, authKey string) *upload {
 	return &upload{
 		ctx:     ctx,
+		chains:  chains,
 		vals:    vals,
 		authKey: authKey,
 	}
@@ -57,22 +60,29 @@ func (u *upload) PostUpload(w http.ResponseWriter, r *http.Request) {
 	}
 
 	chainId := upload.ChainId
-	if _, ok := u.vals[chainId]; !ok {
+	nodes, ok := chainNodes(u.chains, u.vals, chainId)
+	if !ok {
 		util.Write(w, []byte(fmt.Sprintf(`{"error":"chain_id %s not found"}`, chainId)))
 		return
 	}
 
 	nodeIdx := upload.NodeIndex
-	if len(u.vals[chainId]) <= nodeIdx {
+	if len(nodes) <= nodeIdx {
 		util.Write(w, []byte(fmt.Sprintf(`{"error":"node_index %d not found"}`, nodeIdx)))
 		return
 	}
 
-	val := u.vals[chainId][nodeIdx]
+	n := nodes[nodeIdx]
 
 	headerType := r.Header.Get("Upload-Type")
 	switch headerType {
 	case "cosmwasm":
+		val, err := n.cosmosNode(ActionStoreContract)
+		if err != nil {
+			util.WriteError(w, err)
+			return
+		}
+
 		// Upload & Store the contract on chain.
 		codeId, err := val.StoreContract(u.ctx, upload.KeyName, srcPath)
 		if err != nil {
@@ -85,12 +95,17 @@ func (u *upload) PostUpload(w http.ResponseWriter, r *http.Request) {
 	default:
 		// Upload the file to the docker volume (val[0]).
 		_, file := filepath.Split(srcPath)
-		if err := val.CopyFile(u.ctx, srcPath, file); err != nil {
+		content, err := os.ReadFile(srcPath)
+		if err != nil {
+			util.WriteError(w, err)
+			return
+		}
+		if err := n.WriteFile(u.ctx, content, file); err != nil {
 			util.WriteError(w, fmt.Errorf(`{"error":"writing contract file to docker volume: %w"}`, err))
 			return
 		}
 
-		home := val.HomeDir()
+		home := n.HomeDir()
 		fileLoc := filepath.Join(home, file)
 		util.Write(w, []byte(fmt.Sprintf(`{"success":"file uploaded to %s","location":"%s"}`, chainId, fileLoc)))
 	}
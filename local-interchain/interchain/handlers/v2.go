@@ -0,0 +1,694 @@
+package handlers
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/gorilla/mux"
+
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/util"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// V2Prefix is the path prefix of the versioned REST API.
+const V2Prefix = "/v2"
+
+//go:embed openapi.json
+var openAPIDocument []byte
+
+var (
+	errNotFound             = errors.New("not found")
+	errRelayerNotConfigured = errors.New("relayer not configured for this setup")
+	errExists               = errors.New("already exists")
+	errStaticNetwork        = errors.New("chains and paths cannot be added to this network")
+)
+
+// ChainResponse describes a chain of the network. Actions lists the actions its nodes support.
+type ChainResponse struct {
+	IbcChainConfigAlias
+	Nodes    int      `json:"nodes"`
+	IBCPaths []string `json:"ibc_paths"`
+	Actions  []string `json:"actions"`
+}
+
+// NodeResponse describes a node of a chain.
+type NodeResponse struct {
+	Index       int    `json:"index"`
+	Name        string `json:"name"`
+	ContainerID string `json:"container_id"`
+	HostName    string `json:"hostname"`
+	HomeDir     string `json:"home_dir"`
+	Height      int64  `json:"height"`
+}
+
+// CommandRequest is the body of the routes running a command.
+// Placeholders %RPC%, %CHAIN_ID% and %HOME% in Args are replaced by the values of the node.
+type CommandRequest struct {
+	Args []string `json:"args"`
+}
+
+// CommandResponse is the output of a command.
+// JSON holds stdout when it is valid JSON, such as a query with --output=json.
+type CommandResponse struct {
+	Stdout string          `json:"stdout"`
+	Stderr string          `json:"stderr"`
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// WalletResponse describes a key of a chain. Mnemonics are never returned.
+type WalletResponse struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Amount  string `json:"amount,omitempty"`
+}
+
+// RecoverWalletRequest is the body of the route recovering a key into the keyring of the chain's first node.
+type RecoverWalletRequest struct {
+	Name     string `json:"name"`
+	Mnemonic string `json:"mnemonic"`
+}
+
+// FaucetRequest is the body of the route sending funds of the chain's denom from the faucet account.
+type FaucetRequest struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// RelayerResponse describes the relayer of the network.
+type RelayerResponse struct {
+	Type    string `json:"type"`
+	Image   string `json:"image"`
+	HomeDir string `json:"home_dir"`
+	Running bool   `json:"running"`
+}
+
+// PathResponse describes a relayer path between two chains.
+type PathResponse struct {
+	Name     string   `json:"name"`
+	ChainIDs []string `json:"chain_ids"`
+}
+
+// AddPathRequest is the body of the route linking a new relayer path between two running chains.
+type AddPathRequest struct {
+	Name     string   `json:"name"`
+	ChainIDs []string `json:"chain_ids"`
+}
+
+// StartRelayerRequest is the body of the route starting the relayer, on every path if Paths is empty.
+type StartRelayerRequest struct {
+	Paths []string `json:"paths"`
+}
+
+// ShutdownRequest is the body of the route shutting the network down.
+// If Persist is set, the stopped containers and volumes are kept so the network can be resumed.
+type ShutdownRequest struct {
+	Persist bool `json:"persist"`
+}
+
+type v2 struct {
+	ctx    context.Context
+	ic     *interchaintest.Interchain
+	cfg    *types.Config
+	vals   map[string][]*cosmos.ChainNode
+	chains map[string]ibc.Chain
+
+	relayer ibc.Relayer
+	eRep    ibc.RelayerExecReporter
+
+	// network adds chains and paths, one change at a time. It is nil if the network cannot change.
+	network   Network
+	networkMu sync.Mutex
+
+	authKey  string
+	shutdown func(persist bool)
+}
+
+func NewV2(
+	ctx context.Context,
+	ic *interchaintest.Interchain,
+	cfg *types.Config,
+	chains map[string]ibc.Chain,
+	vals map[string][]*cosmos.ChainNode,
+	relayer ibc.Relayer,
+	eRep ibc.RelayerExecReporter,
+	network Network,
+	authKey string,
+	shutdown func(persist bool),
+) *v2 {
+	return &v2{
+		ctx:      ctx,
+		ic:       ic,
+		cfg:      cfg,
+		vals:     vals,
+		chains:   chains,
+		relayer:  relayer,
+		eRep:     eRep,
+		network:  network,
+		authKey:  authKey,
+		shutdown: shutdown,
+	}
+}
+
+// Register adds the routes of the v2 API to r. Routes changing the network require the auth key, if any,
+// as an "Authorization: Bearer <key>" header.
+func (v *v2) Register(r *mux.Router) {
+	get := func(path string, h http.HandlerFunc) {
+		r.HandleFunc(V2Prefix+path, h).Methods(http.MethodGet)
+	}
+	post := func(path string, h http.HandlerFunc) {
+		r.HandleFunc(V2Prefix+path, v.authorized(h)).Methods(http.MethodPost)
+	}
+
+	get("/openapi.json", v.openAPI)
+
+	get("/chains", v.listChains)
+	post("/chains", v.addChain)
+	get("/chains/{chain_id}", v.getChain)
+	get("/chains/{chain_id}/nodes", v.listNodes)
+	get("/chains/{chain_id}/nodes/{node_index}", v.getNode)
+	post("/chains/{chain_id}/nodes/{node_index}/query", v.command(node.Query))
+	post("/chains/{chain_id}/nodes/{node_index}/bin", v.command(node.Bin))
+	post("/chains/{chain_id}/nodes/{node_index}/exec", v.command(node.Exec))
+	get("/chains/{chain_id}/wallets", v.listWallets)
+	post("/chains/{chain_id}/wallets", v.recoverWallet)
+	post("/chains/{chain_id}/faucet", v.faucet)
+	get("/chains/{chain_id}/channels", v.listChannels)
+
+	get("/relayer", v.getRelayer)
+	get("/relayer/paths", v.listPaths)
+	post("/relayer/paths", v.addPath)
+	post("/relayer/start", v.startRelayer)
+	post("/relayer/stop", v.stopRelayer)
+	post("/relayer/exec", v.relayerExec)
+
+	post("/shutdown", v.shutdownNetwork)
+}
+
+func (v *v2) authorized(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if v.authKey != "" && r.Header.Get("Authorization") != "Bearer "+v.authKey {
+			util.WriteErrorStatus(w, http.StatusUnauthorized, fmt.Errorf("invalid or missing auth key"))
+			return
+		}
+		h(w, r)
+	}
+}
+
+func (v *v2) openAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	util.Write(w, openAPIDocument)
+}
+
+func (v *v2) listChains(w http.ResponseWriter, r *http.Request) {
+	cfgChains := configChains(v.cfg)
+	chains := make([]ChainResponse, 0, len(cfgChains))
+	for _, c := range cfgChains {
+		res, err := v.chainResponse(c.ChainID)
+		if err != nil {
+			continue
+		}
+		chains = append(chains, res)
+	}
+	util.WriteJSON(w, http.StatusOK, chains)
+}
+
+// addChain starts the chain of the request body and links its IBC paths to the running chains sharing them.
+func (v *v2) addChain(w http.ResponseWriter, r *http.Request) {
+	if v.network == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errStaticNetwork)
+		return
+	}
+
+	var req types.Chain
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+		return
+	}
+
+	v.networkMu.Lock()
+	defer v.networkMu.Unlock()
+
+	if err := v.validateChain(req); err != nil {
+		util.WriteErrorStatus(w, statusOf(err), err)
+		return
+	}
+	if err := v.network.AddChain(v.ctx, req); err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, fmt.Errorf("failed to add chain: %w", err))
+		return
+	}
+
+	res, err := v.chainResponse(req.ChainID)
+	if err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, res)
+}
+
+// validateChain checks that the chain can be added: its ID is new and each of its IBC paths
+// is shared with a single running chain.
+func (v *v2) validateChain(c types.Chain) error {
+	if c.ChainID == "" || c.Binary == "" || c.Denom == "" || c.Bech32Prefix == "" || c.DockerImage.Repository == "" {
+		return fmt.Errorf("chain_id, binary, denom, bech32_prefix and docker_image.repository are required")
+	}
+	if c.ICSConsumerLink != "" {
+		return fmt.Errorf("ics_consumer_link is not supported on added chains")
+	}
+	if _, ok := chainNodes(v.chains, v.vals, c.ChainID); ok {
+		return fmt.Errorf("chain_id %q %w", c.ChainID, errExists)
+	}
+
+	if len(c.IBCPaths) == 0 {
+		return nil
+	}
+	if v.relayer == nil {
+		return errRelayerNotConfigured
+	}
+	paths := ibcPaths(v.cfg)
+	for _, p := range c.IBCPaths {
+		if len(paths[p]) == 0 {
+			return fmt.Errorf("ibc path %q is not shared with a running chain", p)
+		}
+		if len(paths[p]) > 1 {
+			return fmt.Errorf("ibc path %q %w between %v", p, errExists, paths[p])
+		}
+	}
+	return nil
+}
+
+func (v *v2) getChain(w http.ResponseWriter, r *http.Request) {
+	res, err := v.chainResponse(mux.Vars(r)["chain_id"])
+	if err != nil {
+		util.WriteErrorStatus(w, http.StatusNotFound, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, res)
+}
+
+func (v *v2) chainResponse(chainID string) (ChainResponse, error) {
+	nodes, ok := chainNodes(v.chains, v.vals, chainID)
+	if !ok || len(nodes) == 0 {
+		return ChainResponse{}, fmt.Errorf("chain_id %q %w", chainID, errNotFound)
+	}
+	cfg := nodes[0].chain.Config()
+	res := ChainResponse{
+		IbcChainConfigAlias: IbcChainConfigAlias{
+			Type:           cfg.Type,
+			Name:           cfg.Name,
+			ChainID:        cfg.ChainID,
+			Bin:            cfg.Bin,
+			Bech32Prefix:   cfg.Bech32Prefix,
+			Denom:          cfg.Denom,
+			CoinType:       cfg.CoinType,
+			GasPrices:      cfg.GasPrices,
+			GasAdjustment:  cfg.GasAdjustment,
+			TrustingPeriod: cfg.TrustingPeriod,
+		},
+		Nodes:    len(nodes),
+		IBCPaths: []string{},
+		Actions:  ChainActions(cfg.Type),
+	}
+	if c := v.configChain(chainID); c != nil {
+		res.IBCPaths = c.IBCPaths
+	}
+	return res, nil
+}
+
+func (v *v2) listNodes(w http.ResponseWriter, r *http.Request) {
+	chainID := mux.Vars(r)["chain_id"]
+	nodes, ok := chainNodes(v.chains, v.vals, chainID)
+	if !ok {
+		util.WriteErrorStatus(w, http.StatusNotFound, fmt.Errorf("chain_id %q %w", chainID, errNotFound))
+		return
+	}
+	res := make([]NodeResponse, len(nodes))
+	for i, n := range nodes {
+		res[i] = v.nodeResponse(r.Context(), i, n)
+	}
+	util.WriteJSON(w, http.StatusOK, res)
+}
+
+func (v *v2) getNode(w http.ResponseWriter, r *http.Request) {
+	idx, n, err := v.node(r)
+	if err != nil {
+		util.WriteErrorStatus(w, statusOf(err), err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, v.nodeResponse(r.Context(), idx, n))
+}
+
+func (v *v2) nodeResponse(ctx context.Context, idx int, n node) NodeResponse {
+	height, _ := n.Height(ctx)
+	return NodeResponse{
+		Index:       idx,
+		Name:        n.Name(),
+		ContainerID: n.ContainerID(),
+		HostName:    n.HostName(),
+		HomeDir:     n.HomeDir(),
+		Height:      height,
+	}
+}
+
+// command returns the handler running a command on the node of the request with run.
+func (v *v2) command(run func(n node, ctx context.Context, args []string) ([]byte, []byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, n, err := v.node(r)
+		if err != nil {
+			util.WriteErrorStatus(w, statusOf(err), err)
+			return
+		}
+
+		var req CommandRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+			return
+		}
+		if len(req.Args) == 0 {
+			util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("args must not be empty"))
+			return
+		}
+
+		stdout, stderr, err := run(n, v.ctx, n.replaceVars(req.Args))
+		if errors.Is(err, errUnsupported) {
+			util.WriteErrorStatus(w, statusOf(err), err)
+			return
+		}
+		writeCommandResponse(w, stdout, stderr, err)
+	}
+}
+
+func (v *v2) listWallets(w http.ResponseWriter, r *http.Request) {
+	chainID := mux.Vars(r)["chain_id"]
+	c := v.configChain(chainID)
+	if c == nil {
+		util.WriteErrorStatus(w, http.StatusNotFound, fmt.Errorf("chain_id %q %w", chainID, errNotFound))
+		return
+	}
+
+	wallets := make([]WalletResponse, len(c.Genesis.Accounts))
+	for i, acc := range c.Genesis.Accounts {
+		wallets[i] = WalletResponse{Name: acc.Name, Address: acc.Address, Amount: acc.Amount}
+	}
+	util.WriteJSON(w, http.StatusOK, wallets)
+}
+
+func (v *v2) recoverWallet(w http.ResponseWriter, r *http.Request) {
+	chainID := mux.Vars(r)["chain_id"]
+	nodes, ok := chainNodes(v.chains, v.vals, chainID)
+	if !ok || len(nodes) == 0 {
+		util.WriteErrorStatus(w, http.StatusNotFound, fmt.Errorf("chain_id %q %w", chainID, errNotFound))
+		return
+	}
+	n, err := nodes[0].cosmosNode(ActionRecoverKey)
+	if err != nil {
+		util.WriteErrorStatus(w, statusOf(err), err)
+		return
+	}
+
+	var req RecoverWalletRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+		return
+	}
+	if req.Name == "" || req.Mnemonic == "" {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("name and mnemonic are required"))
+		return
+	}
+
+	if err := n.RecoverKey(v.ctx, req.Name, req.Mnemonic); err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, fmt.Errorf("failed to recover key: %w", err))
+		return
+	}
+	addr, err := n.AccountKeyBech32(v.ctx, req.Name)
+	if err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, WalletResponse{Name: req.Name, Address: addr})
+}
+
+func (v *v2) faucet(w http.ResponseWriter, r *http.Request) {
+	chainID := mux.Vars(r)["chain_id"]
+	nodes, ok := chainNodes(v.chains, v.vals, chainID)
+	if !ok || len(nodes) == 0 {
+		util.WriteErrorStatus(w, http.StatusNotFound, fmt.Errorf("chain_id %q %w", chainID, errNotFound))
+		return
+	}
+
+	var req FaucetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+		return
+	}
+	amt, ok := sdkmath.NewIntFromString(req.Amount)
+	if !ok || req.Address == "" {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("a valid address and integer amount are required"))
+		return
+	}
+
+	if err := nodes[0].Faucet(v.ctx, req.Address, amt); err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, map[string]string{"sent_funds": req.Amount})
+}
+
+func (v *v2) listChannels(w http.ResponseWriter, r *http.Request) {
+	if v.relayer == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errRelayerNotConfigured)
+		return
+	}
+	chainID := mux.Vars(r)["chain_id"]
+	if _, ok := chainNodes(v.chains, v.vals, chainID); !ok {
+		util.WriteErrorStatus(w, http.StatusNotFound, fmt.Errorf("chain_id %q %w", chainID, errNotFound))
+		return
+	}
+
+	channels, err := v.relayer.GetChannels(v.ctx, v.eRep, chainID)
+	if err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, channels)
+}
+
+func (v *v2) getRelayer(w http.ResponseWriter, r *http.Request) {
+	if v.relayer == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errRelayerNotConfigured)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, RelayerResponse{
+		Type:    v.cfg.Relayer.Type,
+		Image:   relayerImage(v.relayer, v.cfg.Relayer),
+		HomeDir: relayerHome(v.relayer),
+		Running: relayerRunning(r.Context(), v.relayer),
+	})
+}
+
+func (v *v2) listPaths(w http.ResponseWriter, r *http.Request) {
+	chainIDs := ibcPaths(v.cfg)
+	paths := make([]PathResponse, 0, len(chainIDs))
+	for name, ids := range chainIDs {
+		paths = append(paths, PathResponse{Name: name, ChainIDs: ids})
+	}
+	sort.Slice(paths, func(i, j int) bool { return paths[i].Name < paths[j].Name })
+	util.WriteJSON(w, http.StatusOK, paths)
+}
+
+// addPath links the relayer path of the request body between two running chains.
+func (v *v2) addPath(w http.ResponseWriter, r *http.Request) {
+	if v.relayer == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errRelayerNotConfigured)
+		return
+	}
+	if v.network == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errStaticNetwork)
+		return
+	}
+
+	var req AddPathRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+		return
+	}
+	if req.Name == "" || len(req.ChainIDs) != 2 || req.ChainIDs[0] == req.ChainIDs[1] {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("name and two distinct chain_ids are required"))
+		return
+	}
+
+	v.networkMu.Lock()
+	defer v.networkMu.Unlock()
+
+	for _, id := range req.ChainIDs {
+		if _, ok := chainNodes(v.chains, v.vals, id); !ok {
+			util.WriteErrorStatus(w, http.StatusNotFound, fmt.Errorf("chain_id %q %w", id, errNotFound))
+			return
+		}
+	}
+	if ids, ok := ibcPaths(v.cfg)[req.Name]; ok {
+		util.WriteErrorStatus(w, http.StatusConflict, fmt.Errorf("ibc path %q %w between %v", req.Name, errExists, ids))
+		return
+	}
+
+	if err := v.network.AddPath(v.ctx, req.Name, [2]string{req.ChainIDs[0], req.ChainIDs[1]}); err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, fmt.Errorf("failed to add path: %w", err))
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, PathResponse{Name: req.Name, ChainIDs: req.ChainIDs})
+}
+
+func (v *v2) startRelayer(w http.ResponseWriter, r *http.Request) {
+	if v.relayer == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errRelayerNotConfigured)
+		return
+	}
+
+	var req StartRelayerRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+			return
+		}
+	}
+
+	if err := v.relayer.StartRelayer(v.ctx, v.eRep, req.Paths...); err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (v *v2) stopRelayer(w http.ResponseWriter, r *http.Request) {
+	if v.relayer == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errRelayerNotConfigured)
+		return
+	}
+
+	if err := v.relayer.StopRelayer(v.ctx, v.eRep); err != nil {
+		util.WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (v *v2) relayerExec(w http.ResponseWriter, r *http.Request) {
+	if v.relayer == nil {
+		util.WriteErrorStatus(w, http.StatusServiceUnavailable, errRelayerNotConfigured)
+		return
+	}
+
+	var req CommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+		return
+	}
+	if len(req.Args) == 0 {
+		util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("args must not be empty"))
+		return
+	}
+
+	res := v.relayer.Exec(v.ctx, v.eRep, relayerCommand(v.relayer, req.Args), []string{})
+	writeCommandResponse(w, res.Stdout, res.Stderr, res.Err)
+}
+
+func (v *v2) shutdownNetwork(w http.ResponseWriter, r *http.Request) {
+	var req ShutdownRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			util.WriteErrorStatus(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+			return
+		}
+	}
+
+	// The network shuts down in the background once the response is written.
+	w.WriteHeader(http.StatusAccepted)
+	v.shutdown(req.Persist)
+}
+
+// node returns the node of the chain_id and node_index route variables.
+func (v *v2) node(r *http.Request) (int, node, error) {
+	vars := mux.Vars(r)
+	chainID := vars["chain_id"]
+	nodes, ok := chainNodes(v.chains, v.vals, chainID)
+	if !ok {
+		return 0, node{}, fmt.Errorf("chain_id %q %w", chainID, errNotFound)
+	}
+
+	idx, err := strconv.Atoi(vars["node_index"])
+	if err != nil || idx < 0 {
+		return 0, node{}, fmt.Errorf("node_index %q is not a valid index", vars["node_index"])
+	}
+	if idx >= len(nodes) {
+		return 0, node{}, fmt.Errorf("node_index %d %w, nodes: %d", idx, errNotFound, len(nodes))
+	}
+	return idx, nodes[idx], nil
+}
+
+// configChain returns a copy of the configuration of the chain with the given ID, or nil if there is none.
+func (v *v2) configChain(chainID string) *types.Chain {
+	for _, c := range configChains(v.cfg) {
+		if c.ChainID == chainID {
+			return &c
+		}
+	}
+	return nil
+}
+
+// statusOf returns the status code of a response reporting err.
+func statusOf(err error) int {
+	if errors.Is(err, errNotFound) {
+		return http.StatusNotFound
+	}
+	if errors.Is(err, errUnsupported) {
+		return http.StatusNotImplemented
+	}
+	if errors.Is(err, errExists) {
+		return http.StatusConflict
+	}
+	if errors.Is(err, errRelayerNotConfigured) {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
+
+// writeCommandResponse writes the output of a command,
+// with the Unprocessable Entity status if the command failed.
+func writeCommandResponse(w http.ResponseWriter, stdout, stderr []byte, err error) {
+	res := CommandResponse{
+		Stdout: string(stdout),
+		Stderr: string(stderr),
+	}
+	if json.Valid(stdout) {
+		res.JSON = stdout
+	}
+
+	status := http.StatusOK
+	if err != nil {
+		res.Error = err.Error()
+		status = http.StatusUnprocessableEntity
+	}
+	util.WriteJSON(w, status, res)
+}
+
+func containsFlag(args []string, flag string) bool {
+	for _, arg := range args {
+		if arg == flag || strings.HasPrefix(arg, flag+"=") {
+			return true
+		}
+	}
+	return false
+}
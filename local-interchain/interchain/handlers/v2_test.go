@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+func TestV2(t *testing.T) {
+	cfg := &types.Config{
+		Chains: []types.Chain{
+			{ChainID: "localjuno-1", IBCPaths: []string{"juno-ibc-1"}},
+			{ChainID: "localjuno-2", IBCPaths: []string{"juno-ibc-1"}},
+		},
+	}
+	r := mux.NewRouter()
+	var persisted bool
+	NewV2(context.Background(), nil, cfg, nil, nil, nil, nil, nil, "secret", func(persist bool) { persisted = persist }).Register(r)
+
+	do := func(method, path, body string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		for i := 0; i+1 < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("openapi", func(t *testing.T) {
+		w := do(http.MethodGet, "/v2/openapi.json", "")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.True(t, json.Valid(w.Body.Bytes()))
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		w := do(http.MethodGet, "/v2/relayer/paths", "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var paths []PathResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &paths))
+		require.Equal(t, []PathResponse{{Name: "juno-ibc-1", ChainIDs: []string{"localjuno-1", "localjuno-2"}}}, paths)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/v2/chains/unknown", "").Code)
+		require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/v2/chains/unknown/wallets", "").Code)
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		body := `{"args": ["bank", "total"]}`
+		w := do(http.MethodPost, "/v2/chains/localjuno-1/nodes/0/query", body)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = do(http.MethodPost, "/v2/chains/localjuno-1/nodes/0/query", body, "Authorization", "Bearer secret")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		w := do(http.MethodPost, "/v2/shutdown", `{"persist": true}`, "Authorization", "Bearer secret")
+		require.Equal(t, http.StatusAccepted, w.Code)
+		require.True(t, persisted)
+	})
+
+	t.Run("relayer not configured", func(t *testing.T) {
+		require.Equal(t, http.StatusServiceUnavailable, do(http.MethodGet, "/v2/relayer", "").Code)
+
+		w := do(http.MethodPost, "/v2/relayer/stop", "", "Authorization", "Bearer secret")
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
+
+// fakeNetwork registers the chains and paths it is asked to add.
+type fakeNetwork struct {
+	cfg    *types.Config
+	chains map[string]ibc.Chain
+}
+
+func (n *fakeNetwork) AddChain(ctx context.Context, chain types.Chain) error {
+	RegisterChain(n.cfg, n.chains, nil, chain, &fakeEthereum{})
+	for _, p := range chain.IBCPaths {
+		var peer string
+		for _, c := range n.cfg.Chains {
+			if len(c.IBCPaths) > 0 && c.IBCPaths[0] == p {
+				peer = c.ChainID
+			}
+		}
+		RegisterPath(n.cfg, p, [2]string{peer, chain.ChainID})
+	}
+	return nil
+}
+
+func (n *fakeNetwork) AddPath(ctx context.Context, path string, chainIDs [2]string) error {
+	RegisterPath(n.cfg, path, chainIDs)
+	return nil
+}
+
+// fakeRelayer is a relayer the handlers only check for.
+type fakeRelayer struct{ ibc.Relayer }
+
+func TestV2Network(t *testing.T) {
+	cfg := &types.Config{
+		Chains: []types.Chain{
+			{ChainID: "31337", IBCPaths: []string{"eth-ibc-1"}},
+		},
+	}
+	chains := map[string]ibc.Chain{"31337": &fakeEthereum{}}
+	r := mux.NewRouter()
+	NewV2(context.Background(), nil, cfg, chains, nil, fakeRelayer{}, nil, &fakeNetwork{cfg: cfg, chains: chains}, "", nil).Register(r)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return w
+	}
+	chain := func(chainID string, paths ...string) string {
+		bz, _ := json.Marshal(types.Chain{
+			ChainID:      chainID,
+			Binary:       "junod",
+			Denom:        "ujuno",
+			Bech32Prefix: "juno",
+			DockerImage:  ibc.DockerImage{Repository: "ghcr.io/strangelove-ventures/heighliner/juno"},
+			IBCPaths:     paths,
+		})
+		return string(bz)
+	}
+
+	t.Run("invalid chain", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/v2/chains", `{"chain_id": "localjuno-1"}`).Code)
+		require.Equal(t, http.StatusConflict, do(http.MethodPost, "/v2/chains", chain("31337")).Code)
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/v2/chains", chain("localjuno-1", "unknown")).Code)
+	})
+
+	t.Run("add chain", func(t *testing.T) {
+		w := do(http.MethodPost, "/v2/chains", chain("localjuno-1", "eth-ibc-1"))
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var res ChainResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, []string{"eth-ibc-1"}, res.IBCPaths)
+
+		// The path now links two chains.
+		require.Equal(t, http.StatusConflict, do(http.MethodPost, "/v2/chains", chain("localjuno-2", "eth-ibc-1")).Code)
+	})
+
+	t.Run("add path", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/v2/relayer/paths", `{"name": "p", "chain_ids": ["31337"]}`).Code)
+		require.Equal(t, http.StatusNotFound, do(http.MethodPost, "/v2/relayer/paths", `{"name": "p", "chain_ids": ["31337", "unknown"]}`).Code)
+		require.Equal(t, http.StatusConflict, do(http.MethodPost, "/v2/relayer/paths", `{"name": "eth-ibc-1", "chain_ids": ["31337", "localjuno-1"]}`).Code)
+
+		w := do(http.MethodPost, "/v2/relayer/paths", `{"name": "eth-ibc-2", "chain_ids": ["31337", "localjuno-1"]}`)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var paths []PathResponse
+		require.NoError(t, json.Unmarshal(do(http.MethodGet, "/v2/relayer/paths", "").Body.Bytes(), &paths))
+		require.Equal(t, []PathResponse{
+			{Name: "eth-ibc-1", ChainIDs: []string{"31337", "localjuno-1"}},
+			{Name: "eth-ibc-2", ChainIDs: []string{"31337", "localjuno-1"}},
+		}, paths)
+	})
+}
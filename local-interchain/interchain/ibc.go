@@ -21,6 +21,17 @@ func VerifyIBCPaths(ibcpaths map[string][]int) error {
 	return nil
 }
 
+// ibcPathIndexes maps each IBC path of the config to the indexes of the chains sharing it.
+func ibcPathIndexes(config *types.Config) map[string][]int {
+	ibcpaths := make(map[string][]int)
+	for idx, cfg := range config.Chains {
+		for _, path := range cfg.IBCPaths {
+			ibcpaths[path] = append(ibcpaths[path], idx)
+		}
+	}
+	return ibcpaths
+}
+
 // TODO: Allow for a single chain to IBC between multiple chains
 func LinkIBCPaths(ibcpaths map[string][]int, chains []ibc.Chain, ic *interchaintest.Interchain, r ibc.Relayer) {
 	for path, c := range ibcpaths {
@@ -39,9 +50,9 @@ func LinkIBCPaths(ibcpaths map[string][]int, chains []ibc.Chain, ic *interchaint
 }
 
 // TODO: Get all channels a chain is connected too. Map it to the said chain_id. Then output to Logs.
-func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chains []ibc.Chain, ic *interchaintest.Interchain, r ibc.Relayer, eRep ibc.RelayerExecReporter) []types.IBCChannel {
+func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chains []ibc.Chain, ic *interchaintest.Interchain, r ibc.Relayer, eRep ibc.RelayerExecReporter) ([]types.IBCChannel, error) {
 	if len(ibcpaths) == 0 {
-		return []types.IBCChannel{}
+		return []types.IBCChannel{}, nil
 	}
 
 	channels := []types.IBCChannel{}
@@ -52,7 +63,7 @@ func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chain
 
 		channel1, err := ibc.GetTransferChannel(ctx, r, eRep, chain1.Config().ChainID, chain2.Config().ChainID)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("failed to get the transfer channel of %s: %w", chain1.Config().ChainID, err)
 		}
 
 		channels = append(channels, types.IBCChannel{
@@ -63,7 +74,7 @@ func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chain
 		// this a duplicate?
 		channel2, err := ibc.GetTransferChannel(ctx, r, eRep, chain2.Config().ChainID, chain1.Config().ChainID)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("failed to get the transfer channel of %s: %w", chain2.Config().ChainID, err)
 		}
 		channels = append(channels, types.IBCChannel{
 			ChainID: chain2.Config().ChainID,
@@ -71,5 +82,5 @@ func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chain
 		})
 	}
 
-	return channels
+	return channels, nil
 }
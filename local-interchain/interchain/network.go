@@ -0,0 +1,286 @@
+package interchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	dockerclient "github.com/docker/docker/client"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"go.uber.org/zap"
+)
+
+// relayerWalletAmount is the amount of the chain denom the relayer key of a chain gets, as in ic.Build.
+var relayerWalletAmount = sdkmath.NewInt(1_000_000_000_000)
+
+// topology adds chains and IBC paths to the running network. It implements handlers.Network.
+type topology struct {
+	logger     *zap.Logger
+	client     *dockerclient.Client
+	networkID  string
+	testName   string
+	installDir string
+	eRep       *testreporter.RelayerExecReporter
+
+	config  *types.Config
+	chains  map[string]ibc.Chain
+	vals    map[string][]*cosmos.ChainNode
+	relayer ibc.Relayer
+
+	// mu serializes changes. The chains, validators and config are only written through the
+	// handlers registration functions while it is held.
+	mu sync.Mutex
+	// paths are the paths the relayer runs on.
+	paths []string
+	// relayed holds the IDs of the chains the relayer has a configuration and key for.
+	relayed map[string]bool
+	// ics built the added chains and are closed with the network.
+	ics []*interchaintest.Interchain
+}
+
+func newTopology(
+	logger *zap.Logger,
+	client *dockerclient.Client,
+	networkID, testName, installDir string,
+	eRep *testreporter.RelayerExecReporter,
+	config *types.Config,
+	chains map[string]ibc.Chain,
+	vals map[string][]*cosmos.ChainNode,
+	relayer ibc.Relayer,
+	paths []string,
+) *topology {
+	// The relayer was configured for the chains of the IBC paths and ICS links of the config on build.
+	relayed := make(map[string]bool)
+	for _, c := range config.Chains {
+		if len(c.IBCPaths) > 0 || c.ICSConsumerLink != "" {
+			relayed[c.ChainID] = true
+		}
+		if c.ICSConsumerLink != "" {
+			relayed[c.ICSConsumerLink] = true
+		}
+	}
+
+	return &topology{
+		logger:     logger,
+		client:     client,
+		networkID:  networkID,
+		testName:   testName,
+		installDir: installDir,
+		eRep:       eRep,
+		config:     config,
+		chains:     chains,
+		vals:       vals,
+		relayer:    relayer,
+		paths:      append([]string{}, paths...),
+		relayed:    relayed,
+	}
+}
+
+// Paths returns the paths the relayer runs on.
+func (t *topology) Paths() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string{}, t.paths...)
+}
+
+// Close closes the interchains of the added chains.
+func (t *topology) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, ic := range t.ics {
+		_ = ic.Close()
+	}
+}
+
+// AddChain starts the chain on the docker network with the test name of the network, so it is
+// shut down or persisted along with the other chains. The IBC paths of the chain are linked to the
+// running chain sharing each of them.
+func (t *topology) AddChain(ctx context.Context, chainCfg types.Chain) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	chainCfg = chainWithDefaults(chainCfg)
+	if _, ok := t.chains[chainCfg.ChainID]; ok {
+		return fmt.Errorf("chain %s already exists", chainCfg.ChainID)
+	}
+	if chainCfg.ICSConsumerLink != "" {
+		return fmt.Errorf("chain %s: ICS consumers can only be started with the network", chainCfg.ChainID)
+	}
+
+	peers := make(map[string]string, len(chainCfg.IBCPaths))
+	for _, p := range chainCfg.IBCPaths {
+		peer, err := t.pathPeer(p)
+		if err != nil {
+			return err
+		}
+		peers[p] = peer
+	}
+
+	_, chainSpec := CreateChainConfigs(chainCfg)
+	chains, err := interchaintest.NewBuiltinChainFactory(t.logger, []*interchaintest.ChainSpec{chainSpec}).Chains(t.testName)
+	if err != nil {
+		return fmt.Errorf("cf.Chains: %w", err)
+	}
+	chain := chains[0]
+
+	// The chain is built on its own, it joins the running chains through the relayer only.
+	cfg := &types.Config{Chains: []types.Chain{chainCfg}}
+	ic := interchaintest.NewInterchain().AddChain(chain)
+	ic.AdditionalGenesisWallets = SetupGenesisWallets(cfg, chains)
+	t.ics = append(t.ics, ic)
+
+	if err := ic.Build(ctx, t.eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.testName,
+		Client:    t.client,
+		NetworkID: t.networkID,
+	}); err != nil {
+		return fmt.Errorf("ic.Build: %w", err)
+	}
+
+	AddGenesisKeysToKeyring(ctx, cfg, chains)
+	PostStartupCommands(ctx, cfg, chains)
+
+	handlers.RegisterChain(t.config, t.chains, t.vals, chainCfg, chain)
+	t.logger.Info("Added chain", zap.String("chain_id", chainCfg.ChainID))
+
+	for _, p := range chainCfg.IBCPaths {
+		if err := t.addPath(ctx, p, [2]string{peers[p], chainCfg.ChainID}); err != nil {
+			return err
+		}
+	}
+
+	t.dumpLogs(ctx)
+	return nil
+}
+
+// AddPath links the path between two running chains and restarts the relayer on every path.
+func (t *topology) AddPath(ctx context.Context, path string, chainIDs [2]string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.addPath(ctx, path, chainIDs); err != nil {
+		return err
+	}
+
+	t.dumpLogs(ctx)
+	return nil
+}
+
+func (t *topology) addPath(ctx context.Context, path string, chainIDs [2]string) error {
+	if t.relayer == nil {
+		return errors.New("relayer not configured for this setup")
+	}
+
+	for _, id := range chainIDs {
+		chain, ok := t.chains[id]
+		if !ok {
+			return fmt.Errorf("chain %s not found", id)
+		}
+		if err := t.relayChain(ctx, chain); err != nil {
+			return err
+		}
+	}
+
+	if err := t.relayer.GeneratePath(ctx, t.eRep, chainIDs[0], chainIDs[1], path); err != nil {
+		return fmt.Errorf("failed to generate path %s between chains %s and %s: %w", path, chainIDs[0], chainIDs[1], err)
+	}
+	if err := t.relayer.LinkPath(ctx, t.eRep, path, ibc.DefaultChannelOpts(), ibc.DefaultClientOpts()); err != nil {
+		return fmt.Errorf("failed to link path %s: %w", path, err)
+	}
+
+	handlers.RegisterPath(t.config, path, chainIDs)
+	t.paths = append(t.paths, path)
+	t.logger.Info("Linked path", zap.String("path", path), zap.Strings("chain_ids", chainIDs[:]))
+
+	// The relayer only runs on the paths it was started with.
+	if err := t.relayer.StopRelayer(ctx, t.eRep); err != nil {
+		return fmt.Errorf("relayer.StopRelayer: %w", err)
+	}
+	if err := t.relayer.StartRelayer(ctx, t.eRep, t.paths...); err != nil {
+		return fmt.Errorf("relayer.StartRelayer: %w", err)
+	}
+	return nil
+}
+
+// relayChain adds the configuration and a key of the chain to the relayer, unless it has them already.
+// The chain is past genesis, so the faucet funds the key.
+func (t *topology) relayChain(ctx context.Context, chain ibc.Chain) error {
+	chainID := chain.Config().ChainID
+	if t.relayed[chainID] {
+		return nil
+	}
+
+	rpcAddr, grpcAddr := chain.GetRPCAddress(), chain.GetGRPCAddress()
+	if !t.relayer.UseDockerNetwork() {
+		rpcAddr, grpcAddr = chain.GetHostRPCAddress(), chain.GetHostGRPCAddress()
+	}
+	if err := t.relayer.AddChainConfiguration(ctx, t.eRep, chain.Config(), chainID, rpcAddr, grpcAddr); err != nil {
+		return fmt.Errorf("failed to configure relayer for chain %s: %w", chainID, err)
+	}
+
+	wallet, err := chain.BuildRelayerWallet(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("failed to build relayer wallet for chain %s: %w", chainID, err)
+	}
+	if err := chain.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+		Address: wallet.FormattedAddress(),
+		Denom:   chain.Config().Denom,
+		Amount:  relayerWalletAmount,
+	}); err != nil {
+		return fmt.Errorf("failed to fund relayer wallet for chain %s: %w", chainID, err)
+	}
+	if err := t.relayer.RestoreKey(ctx, t.eRep, chain.Config(), chainID, wallet.Mnemonic()); err != nil {
+		return fmt.Errorf("failed to restore key to relayer for chain %s: %w", chainID, err)
+	}
+
+	t.relayed[chainID] = true
+	return nil
+}
+
+// pathPeer returns the ID of the running chain with the IBC path, which must not link two chains yet.
+func (t *topology) pathPeer(path string) (string, error) {
+	var ids []string
+	for _, c := range t.config.Chains {
+		for _, p := range c.IBCPaths {
+			if p == path {
+				ids = append(ids, c.ChainID)
+			}
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("ibc path '%s' is not shared with a running chain", path)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("ibc path '%s' already links chains %v", path, ids)
+	}
+}
+
+// dumpLogs saves the chains and channels of the network to the logs.json file.
+func (t *topology) dumpLogs(ctx context.Context) {
+	ibcpaths := make(map[string][]int)
+	chains := make([]ibc.Chain, len(t.config.Chains))
+	for idx, c := range t.config.Chains {
+		chains[idx] = t.chains[c.ChainID]
+		for _, p := range c.IBCPaths {
+			ibcpaths[p] = append(ibcpaths[p], idx)
+		}
+	}
+
+	// The chain or path was added, so the logs are saved without the channels if the relayer fails to list them.
+	connections, err := GetChannelConnections(ctx, ibcpaths, chains, nil, t.relayer, t.eRep)
+	if err != nil {
+		t.logger.Error("Failed to get the IBC channels of the network", zap.Error(err))
+		connections = []types.IBCChannel{}
+	}
+	DumpChainsInfoToLogs(t.installDir, t.config, chains, connections)
+}
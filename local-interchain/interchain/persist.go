@@ -0,0 +1,93 @@
+package interchain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+)
+
+// PersistedDir holds, relative to the install directory, the records of networks that can be resumed.
+const PersistedDir = "configs/persisted"
+
+// PersistedNetwork records a network that was stopped with its docker resources kept,
+// so that ResumeChain can start it again.
+type PersistedNetwork struct {
+	// TestName labels the docker resources of the network.
+	TestName string `json:"test_name"`
+
+	NetworkID string `json:"network_id"`
+
+	// RelayerVolume holds the relayer's home directory, if the network has a relayer.
+	RelayerVolume string `json:"relayer_volume,omitempty"`
+
+	// IBCPaths are the relayer paths to start on resume.
+	IBCPaths []string `json:"ibc_paths,omitempty"`
+
+	Config *types.Config `json:"config"`
+}
+
+// NetworkName returns the name a network started from chainCfgFile is persisted under.
+func NetworkName(chainCfgFile string) string {
+	return strings.TrimSuffix(chainCfgFile, path.Ext(chainCfgFile))
+}
+
+// SavePersistedNetwork saves the record of the network with the given name.
+func SavePersistedNetwork(installDir, name string, n PersistedNetwork) error {
+	dir := filepath.Join(installDir, PersistedDir)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(n, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, name+".json"), bz, 0o644)
+}
+
+// LoadPersistedNetwork loads the record of the network with the given name.
+func LoadPersistedNetwork(installDir, name string) (*PersistedNetwork, error) {
+	bz, err := os.ReadFile(filepath.Join(installDir, PersistedDir, name+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no persisted network named %q, stop a network with --persist or start it with --keep first", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var n PersistedNetwork
+	if err := json.Unmarshal(bz, &n); err != nil {
+		return nil, fmt.Errorf("failed to decode persisted network %q: %w", name, err)
+	}
+	return &n, nil
+}
+
+// RemovePersistedNetwork removes the record of the network with the given name, if any.
+func RemovePersistedNetwork(installDir, name string) {
+	_ = os.Remove(filepath.Join(installDir, PersistedDir, name+".json"))
+}
+
+// ListPersistedNetworks returns the names of the networks that can be resumed.
+func ListPersistedNetworks(installDir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(installDir, PersistedDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && path.Ext(e.Name()) == ".json" {
+			names = append(names, NetworkName(e.Name()))
+		}
+	}
+	return names, nil
+}
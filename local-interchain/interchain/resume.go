@@ -0,0 +1,85 @@
+package interchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	dockerclient "github.com/docker/docker/client"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	interchaintestrelayer "github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+// ResumeChain starts the persisted network with the given name from its kept containers and volumes,
+// and serves the API until the process is interrupted, like StartChain.
+// The chains keep their state, chain IDs and host ports. Relayer paths are started again from the kept relayer home.
+func ResumeChain(installDir, name string, ac *types.AppStartConfig) {
+	if err := resumeChain(installDir, name, ac); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatal(err)
+	}
+}
+
+func resumeChain(installDir, name string, ac *types.AppStartConfig) error {
+	n, err := LoadPersistedNetwork(installDir, name)
+	if err != nil {
+		return err
+	}
+
+	return serveNetwork(context.Background(), networkRun{
+		installDir: installDir,
+		name:       name,
+		testName:   n.TestName,
+		config:     n.Config,
+		ac:         ac,
+
+		docker: func(ctx context.Context, _ FakeTesting) (*dockerclient.Client, string, error) {
+			client, err := dockerclient.NewClientWithOpts(dockerclient.FromEnv)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to create docker client: %w", err)
+			}
+			client.NegotiateAPIVersion(ctx)
+			return client, n.NetworkID, nil
+		},
+
+		relayer: func(env networkEnv) ibc.Relayer {
+			if n.RelayerVolume == "" {
+				return nil
+			}
+			return newRelayer(env.logger, n.Config.Relayer, env.t, env.client, env.networkID, interchaintestrelayer.HomeVolume(n.RelayerVolume))
+		},
+
+		// The network was built by another process, so its interchain only backs the API handlers.
+		build: func(ctx context.Context, env networkEnv, net *runningNetwork) error {
+			chainSpecs := []*interchaintest.ChainSpec{}
+			for _, cfg := range n.Config.Chains {
+				_, chainSpec := CreateChainConfigs(cfg)
+				chainSpecs = append(chainSpecs, chainSpec)
+			}
+
+			cf := interchaintest.NewBuiltinChainFactory(env.logger, chainSpecs)
+			chains, err := cf.Chains(n.TestName)
+			if err != nil {
+				return fmt.Errorf("cf.Chains: %w", err)
+			}
+
+			for _, chain := range chains {
+				cosmosChain, ok := chain.(*cosmos.CosmosChain)
+				if !ok {
+					return fmt.Errorf("resuming chain %s: only cosmos chains can be resumed", chain.Config().ChainID)
+				}
+
+				if err := cosmosChain.Resume(ctx, n.TestName, env.client, env.networkID); err != nil {
+					return err
+				}
+			}
+
+			net.chains = chains
+			net.relayerPaths = n.IBCPaths
+			return nil
+		},
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"connectrpc.com/connect"
 	"github.com/gorilla/mux"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1/localinterchainv1connect"
 	ictypes "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
 	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/util"
 	"github.com/strangelove-ventures/interchaintest/v8"
@@ -24,24 +26,35 @@ func NewRouter(
 	ctx context.Context,
 	ic *interchaintest.Interchain,
 	config *ictypes.Config,
-	cosmosChains map[string]*cosmos.CosmosChain,
+	chains map[string]ibc.Chain,
 	vals map[string][]*cosmos.ChainNode,
 	relayer ibc.Relayer,
 	authKey string,
 	eRep ibc.RelayerExecReporter,
+	network handlers.Network,
 	installDir string,
+	shutdown func(persist bool),
 ) *mux.Router {
 	r := mux.NewRouter()
 
-	infoH := handlers.NewInfo(config, installDir, ctx, ic, cosmosChains, vals, relayer, eRep)
+	infoH := handlers.NewInfo(config, installDir, ctx, ic, chains, vals, relayer, eRep)
 	r.HandleFunc("/info", infoH.GetInfo).Methods(http.MethodGet)
 
-	actionsH := handlers.NewActions(ctx, ic, cosmosChains, vals, relayer, eRep, authKey)
+	actionsH := handlers.NewActions(ctx, ic, chains, vals, relayer, eRep, authKey, shutdown)
 	r.HandleFunc("/", actionsH.PostActions).Methods(http.MethodPost)
 
-	uploaderH := handlers.NewUploader(ctx, vals, authKey)
+	uploaderH := handlers.NewUploader(ctx, chains, vals, authKey)
 	r.HandleFunc("/upload", uploaderH.PostUpload).Methods(http.MethodPost)
 
+	handlers.NewV2(ctx, ic, config, chains, vals, relayer, eRep, network, authKey, shutdown).Register(r)
+
+	// gRPC, gRPC-Web and Connect share the REST address. gRPC requires the server to accept h2c.
+	rpcPath, rpcH := localinterchainv1connect.NewLocalInterchainServiceHandler(
+		handlers.NewRPC(ctx, ic, config, chains, vals, relayer, eRep),
+		connect.WithInterceptors(handlers.NewAuthInterceptor(authKey)),
+	)
+	r.PathPrefix(rpcPath).Handler(rpcH).Methods(http.MethodPost, http.MethodGet)
+
 	availableRoutes := getAllMethods(*r)
 	r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		jsonRes, err := json.MarshalIndent(availableRoutes, "", "  ")
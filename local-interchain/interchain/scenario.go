@@ -0,0 +1,190 @@
+package interchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	types "github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+)
+
+// ContractsDir is the directory of installDir the store_contract steps of a scenario read contracts from.
+const ContractsDir = "contracts"
+
+// RunScenario runs the steps of the scenario of the config in order, saving the outputs of the steps
+// for the steps after them. It stops at the first failing step.
+func RunScenario(ctx context.Context, installDir string, config *types.Config, chains map[string]ibc.Chain) error {
+	vars := make(map[string]string)
+	for i, step := range config.Scenario {
+		step, err := step.Expand(vars)
+		if err != nil {
+			return fmt.Errorf("scenario step %d (%s): %w", i, step.Description(), err)
+		}
+
+		log.Println("Running scenario step", i, step.ChainID, step.Description())
+		out, err := runScenarioStep(ctx, installDir, chains[step.ChainID], step)
+		if err != nil {
+			return fmt.Errorf("scenario step %d (%s): %w", i, step.Description(), err)
+		}
+
+		if step.Save != "" {
+			vars[step.Save] = out
+			log.Printf("Saved scenario output ${%s}=%s\n", step.Save, out)
+		}
+	}
+	return nil
+}
+
+// runScenarioStep runs the step on the chain and returns its output. The step was validated with the config,
+// so actions only supported on cosmos chains get a cosmos chain.
+func runScenarioStep(ctx context.Context, installDir string, chain ibc.Chain, step types.ScenarioStep) (string, error) {
+	cc, _ := chain.(*cosmos.CosmosChain)
+
+	switch step.Action {
+	case types.StepStoreContract:
+		return cc.StoreContract(ctx, step.KeyName(), filepath.Join(installDir, ContractsDir, step.File))
+
+	case types.StepInstantiateContract:
+		msg, err := step.MsgJSON()
+		if err != nil {
+			return "", err
+		}
+		args := contractArgs(step)
+		if step.Admin != "" {
+			args = append(args, "--admin", step.Admin)
+		}
+		return cc.InstantiateContract(ctx, step.KeyName(), step.CodeID, msg, step.Admin == "", args...)
+
+	case types.StepExecuteContract:
+		msg, err := step.MsgJSON()
+		if err != nil {
+			return "", err
+		}
+		res, err := cc.ExecuteContract(ctx, step.KeyName(), step.Contract, msg, contractArgs(step)...)
+		if err != nil {
+			return "", err
+		}
+		return res.TxHash, nil
+
+	case types.StepFund:
+		amount, err := walletAmount(chain, step)
+		if err != nil {
+			return "", err
+		}
+		return "", chain.SendFunds(ctx, step.KeyName(), amount)
+
+	case types.StepIBCTransfer:
+		amount, err := walletAmount(chain, step)
+		if err != nil {
+			return "", err
+		}
+		tx, err := chain.SendIBCTransfer(ctx, step.Channel, step.KeyName(), amount, ibc.TransferOptions{Memo: step.Memo})
+		if err != nil {
+			return "", err
+		}
+		return tx.TxHash, nil
+
+	case types.StepSubmitProposal:
+		prop, err := step.ProposalV1()
+		if err != nil {
+			return "", err
+		}
+		tx, err := cc.SubmitProposal(ctx, step.KeyName(), prop)
+		if err != nil {
+			return "", err
+		}
+		if step.Vote != "" {
+			if err := vote(ctx, cc, tx.ProposalID, step.Vote); err != nil {
+				return "", err
+			}
+		}
+		return tx.ProposalID, nil
+
+	case types.StepVote:
+		return "", vote(ctx, cc, step.ProposalID, step.Vote)
+
+	case types.StepQuery:
+		stdout, _, err := cc.GetNode().ExecQuery(ctx, strings.Fields(step.Query)...)
+		if err != nil {
+			return "", err
+		}
+		return types.QueryValue(stdout, step.Path)
+
+	case types.StepWaitBlocks:
+		if err := testutil.WaitForBlocks(ctx, step.Blocks, chain); err != nil {
+			return "", err
+		}
+		height, err := chain.Height(ctx)
+		return strconv.FormatInt(height, 10), err
+
+	case types.StepWaitQuery:
+		return waitQuery(ctx, cc, step)
+
+	default:
+		return "", fmt.Errorf("unknown action %q", step.Action)
+	}
+}
+
+// waitQuery runs the query of the step every block until its value is the expected one.
+// Failing queries are retried, as the queried state may not exist yet.
+func waitQuery(ctx context.Context, cc *cosmos.CosmosChain, step types.ScenarioStep) (string, error) {
+	blocks := step.Blocks
+	if blocks == 0 {
+		blocks = types.DefaultWaitQueryBlocks
+	}
+
+	var last string
+	for i := 0; i < blocks; i++ {
+		stdout, stderr, err := cc.GetNode().ExecQuery(ctx, strings.Fields(step.Query)...)
+		if err == nil {
+			last, err = types.QueryValue(stdout, step.Path)
+		}
+		if err != nil {
+			last = fmt.Sprintf("%v %s", err, stderr)
+		} else if last == step.Expect {
+			return last, nil
+		}
+
+		if err := testutil.WaitForBlocks(ctx, 1, cc); err != nil {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("'%s' is not %q after %d blocks, last: %s", step.Query, step.Expect, blocks, last)
+}
+
+// contractArgs are the extra transaction arguments of an instantiate or execute step.
+func contractArgs(step types.ScenarioStep) []string {
+	if step.Funds == "" {
+		return nil
+	}
+	return []string{"--amount", step.Funds}
+}
+
+// walletAmount is the amount of a fund or ibc_transfer step, in the denom of the chain unless the step has one.
+func walletAmount(chain ibc.Chain, step types.ScenarioStep) (ibc.WalletAmount, error) {
+	amount, ok := sdkmath.NewIntFromString(step.Amount)
+	if !ok {
+		return ibc.WalletAmount{}, fmt.Errorf("invalid amount %q", step.Amount)
+	}
+
+	denom := step.Denom
+	if denom == "" {
+		denom = chain.Config().Denom
+	}
+	return ibc.WalletAmount{Address: step.Address, Denom: denom, Amount: amount}, nil
+}
+
+func vote(ctx context.Context, cc *cosmos.CosmosChain, proposalID, option string) error {
+	id, err := strconv.ParseUint(proposalID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid proposal ID %q", proposalID)
+	}
+	return cc.VoteOnProposalAllValidators(ctx, id, option)
+}
@@ -0,0 +1,40 @@
+package interchain
+
+import (
+	"context"
+	"log"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
+)
+
+// containerStopTimeout is how long, in seconds, containers get to exit before they are killed.
+const containerStopTimeout = 10
+
+// ShutdownNetwork stops the containers started for t, including the chains and the relayer.
+// Unless keep is set, the containers are then removed along with their volumes and network.
+func ShutdownNetwork(ctx context.Context, cli *client.Client, t dockerutil.DockerSetupTestingT, keep bool) {
+	if !keep {
+		dockerutil.DockerCleanup(t, cli)()
+		return
+	}
+
+	cs, err := cli.ContainerList(ctx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", dockerutil.CleanupLabel+"="+t.Name())),
+	})
+	if err != nil {
+		log.Println("Failed to list containers:", err)
+		return
+	}
+
+	timeout := containerStopTimeout
+	for _, c := range cs {
+		if err := cli.ContainerStop(ctx, c.ID, container.StopOptions{Timeout: &timeout}); dockerutil.IsLoggableStopError(err) {
+			log.Printf("Failed to stop container %s: %v\n", c.ID, err)
+		}
+	}
+	log.Printf("Kept %d stopped containers and their volumes\n", len(cs))
+}
@@ -2,13 +2,20 @@ package interchain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
 	"net/http"
+	"os"
+	"os/signal"
 	"path"
 	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
 
+	dockerclient "github.com/docker/docker/client"
 	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/router"
 	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/types"
 	"github.com/strangelove-ventures/interchaintest/v8"
@@ -18,237 +25,429 @@ import (
 	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
 	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
-func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// shutdownTimeout bounds how long the REST server waits for in-flight requests on shutdown.
+const shutdownTimeout = 5 * time.Second
 
-	var relayer ibc.Relayer
-	var eRep *testreporter.RelayerExecReporter
-
-	vals := make(map[string][]*cosmos.ChainNode)
-	ic := interchaintest.NewInterchain()
-	defer ic.Close()
-
-	// TODO: cleanup on ctrl + c
-	// Properly cleanup at the start, during build, and after the REST API is created
-	// The following only works at the start and after the REST API is created.
-	//
-	// c := make(chan os.Signal, 1)
-	// signal.Notify(c, os.Interrupt)
-	// go func() {
-	// 	for sig := range c {
-	// 		log.Printf("Closing from signal: %s\n", sig)
-	// 		handlers.KillAll(ctx, ic, vals, relayer, eRep)
-	// 	}
-	// }()
-
-	// Logger for ICTest functions only.
-	logger, err := InitLogger()
-	if err != nil {
-		panic(err)
+// StartChain starts the network of the config and serves the API until the process is interrupted.
+// On interrupt, the API, relayer and chains are stopped and their docker resources removed,
+// unless ac.Keep is set.
+func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
+	if err := startChain(installDir, chainCfgFile, ac); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatal(err)
 	}
+}
 
+func startChain(installDir, chainCfgFile string, ac *types.AppStartConfig) error {
 	config := ac.Cfg
 
-	config.Relayer = ac.Relayer
-
-	WriteRunningChains(installDir, []byte("{}"))
+	// The relayer flags of the command line take precedence over the config.
+	config.Relayer = config.Relayer.Override(ac.Relayer).WithDefaults()
+	if _, err := config.Relayer.Implementation(); err != nil {
+		return err
+	}
+	if err := config.ValidateScenario(); err != nil {
+		return err
+	}
 
 	// ibc-path-name -> index of []cosmos.CosmosChain
-	ibcpaths := make(map[string][]int)
+	ibcpaths := ibcPathIndexes(config)
 	// providerChainId -> []consumerChainIds
 	icsPair := make(map[string][]string)
 
 	chainSpecs := []*interchaintest.ChainSpec{}
 
-	for idx, cfg := range config.Chains {
+	for _, cfg := range config.Chains {
 		_, chainSpec := CreateChainConfigs(cfg)
 		chainSpecs = append(chainSpecs, chainSpec)
 
-		if len(cfg.IBCPaths) > 0 {
-			for _, path := range cfg.IBCPaths {
-				ibcpaths[path] = append(ibcpaths[path], idx)
-			}
-		}
-
 		if cfg.ICSConsumerLink != "" {
 			icsPair[cfg.ICSConsumerLink] = append(icsPair[cfg.ICSConsumerLink], cfg.ChainID)
 		}
 	}
 
 	if err := VerifyIBCPaths(ibcpaths); err != nil {
-		log.Fatal("VerifyIBCPaths", err)
+		return fmt.Errorf("VerifyIBCPaths: %w", err)
 	}
 
-	// Create chain factory for all the chains
-	cf := interchaintest.NewBuiltinChainFactory(logger, chainSpecs)
-
 	testName := GetTestName(chainCfgFile)
+	networkName := NetworkName(chainCfgFile)
 
-	chains, err := cf.Chains(testName)
-	if err != nil {
-		log.Fatal("cf.Chains", err)
-	}
+	icsProviderPaths := make(map[string]ibc.Chain)
 
-	for _, chain := range chains {
-		ic = ic.AddChain(chain)
-	}
-	ic.AdditionalGenesisWallets = SetupGenesisWallets(config, chains)
+	return serveNetwork(context.Background(), networkRun{
+		installDir: installDir,
+		name:       networkName,
+		testName:   testName,
+		config:     config,
+		ac:         ac,
+
+		docker: func(_ context.Context, t FakeTesting) (*dockerclient.Client, string, error) {
+			// DockerSetup removes the resources of a previous run with the same name, persisted or not.
+			client, network := interchaintest.DockerSetup(t)
+			RemovePersistedNetwork(installDir, networkName)
+			return client, network, nil
+		},
+
+		relayer: func(env networkEnv) ibc.Relayer {
+			// setup a relayer if we have IBC paths to use.
+			if len(ibcpaths) == 0 && len(icsPair) == 0 {
+				return nil
+			}
+			return newRelayer(env.logger, config.Relayer, env.t, env.client, env.networkID)
+		},
 
-	fakeT := FakeTesting{
-		FakeName: testName,
-	}
+		build: func(ctx context.Context, env networkEnv, net *runningNetwork) error {
+			// Create chain factory for all the chains
+			cf := interchaintest.NewBuiltinChainFactory(env.logger, chainSpecs)
 
-	// Base setup
-	rep := testreporter.NewNopReporter()
-	eRep = rep.RelayerExecReporter(&fakeT)
-
-	client, network := interchaintest.DockerSetup(fakeT)
-
-	// setup a relayer if we have IBC paths to use.
-	if len(ibcpaths) > 0 || len(icsPair) > 0 {
-		rlyCfg := config.Relayer
-
-		relayerType, relayerName := ibc.CosmosRly, "relay"
-		rf := interchaintest.NewBuiltinRelayerFactory(
-			relayerType,
-			logger,
-			interchaintestrelayer.CustomDockerImage(
-				rlyCfg.DockerImage.Repository,
-				rlyCfg.DockerImage.Version,
-				rlyCfg.DockerImage.UidGid,
-			),
-			interchaintestrelayer.StartupFlags(rlyCfg.StartupFlags...),
-		)
-
-		// This also just needs the name.
-		relayer = rf.Build(fakeT, client, network)
-		ic = ic.AddRelayer(relayer, relayerName)
-
-		// Add links between chains
-		LinkIBCPaths(ibcpaths, chains, ic, relayer)
-	}
+			chains, err := cf.Chains(testName)
+			if err != nil {
+				return fmt.Errorf("cf.Chains: %w", err)
+			}
+			net.chains = chains
 
-	// Add Interchain Security chain pairs together
-	icsProviderPaths := make(map[string]ibc.Chain)
-	if len(icsPair) > 0 {
-		for provider, consumers := range icsPair {
-			var p, c ibc.Chain
-
-			// a provider can have multiple consumers
-			for _, consumer := range consumers {
-				for _, chain := range chains {
-					if chain.Config().ChainID == provider {
-						p = chain
+			ic := net.ic
+			for _, chain := range chains {
+				ic = ic.AddChain(chain)
+			}
+			ic.AdditionalGenesisWallets = SetupGenesisWallets(config, chains)
+
+			relayer := net.relayer
+			if relayer != nil {
+				relayerName := "relay"
+				ic = ic.AddRelayer(relayer, relayerName)
+
+				// Add links between chains
+				LinkIBCPaths(ibcpaths, chains, ic, relayer)
+			}
+
+			// Add Interchain Security chain pairs together
+			for provider, consumers := range icsPair {
+				var p, c ibc.Chain
+
+				// a provider can have multiple consumers
+				for _, consumer := range consumers {
+					for _, chain := range chains {
+						if chain.Config().ChainID == provider {
+							p = chain
+						}
+						if chain.Config().ChainID == consumer {
+							c = chain
+						}
 					}
-					if chain.Config().ChainID == consumer {
-						c = chain
+				}
+
+				pathName := fmt.Sprintf("%s-%s", p.Config().ChainID, c.Config().ChainID)
+
+				env.logger.Info("Adding ICS pair", zap.String("provider", p.Config().ChainID), zap.String("consumer", c.Config().ChainID), zap.String("path", pathName))
+
+				icsProviderPaths[pathName] = p
+
+				ic = ic.AddProviderConsumerLink(interchaintest.ProviderConsumerLink{
+					Provider: p,
+					Consumer: c,
+					Relayer:  relayer,
+					Path:     pathName,
+				})
+			}
+
+			// Build all chains & begin.
+			err = ic.Build(ctx, env.eRep, interchaintest.InterchainBuildOptions{
+				TestName:         testName,
+				Client:           env.client,
+				NetworkID:        env.networkID,
+				SkipPathCreation: false,
+				// BlockDatabaseFile: interchaintest.DefaultBlockDatabaseFilepath(),
+			})
+			if err != nil {
+				return fmt.Errorf("ic.Build: %w", err)
+			}
+
+			if relayer != nil {
+				for k := range ibcpaths {
+					net.relayerPaths = append(net.relayerPaths, k)
+				}
+			}
+			return nil
+		},
+
+		setup: func(ctx context.Context, env networkEnv, net *runningNetwork) error {
+			// ICS provider setup
+			if len(icsProviderPaths) > 0 {
+				env.logger.Info("ICS provider setup", zap.Any("icsProviderPaths", icsProviderPaths))
+
+				for ibcPath, chain := range icsProviderPaths {
+					if provider, ok := chain.(*cosmos.CosmosChain); ok {
+						if err := provider.FinishICSProviderSetup(ctx, net.relayer, env.eRep, ibcPath); err != nil {
+							return fmt.Errorf("FinishICSProviderSetup: %w", err)
+						}
 					}
 				}
 			}
 
-			pathName := fmt.Sprintf("%s-%s", p.Config().ChainID, c.Config().ChainID)
+			AddGenesisKeysToKeyring(ctx, config, net.chains)
 
-			logger.Info("Adding ICS pair", zap.String("provider", p.Config().ChainID), zap.String("consumer", c.Config().ChainID), zap.String("path", pathName))
+			// run commands for each server after startup. Iterate chain configs
+			PostStartupCommands(ctx, config, net.chains)
 
-			icsProviderPaths[pathName] = p
+			return RunScenario(ctx, installDir, config, net.chainsByID)
+		},
+	})
+}
 
-			ic = ic.AddProviderConsumerLink(interchaintest.ProviderConsumerLink{
-				Provider: p,
-				Consumer: c,
-				Relayer:  relayer,
-				Path:     pathName,
-			})
+// networkRun holds what starting and resuming a network differ in. serveNetwork runs the rest of its lifecycle.
+type networkRun struct {
+	installDir string
+	// name is the name the network is persisted under.
+	name     string
+	testName string
+	config   *types.Config
+	ac       *types.AppStartConfig
+
+	// docker returns the docker client and the ID of the network the chains run on.
+	docker func(ctx context.Context, t FakeTesting) (*dockerclient.Client, string, error)
+	// relayer creates the relayer of the network, or returns nil if it has none.
+	relayer func(env networkEnv) ibc.Relayer
+	// build builds or resumes the chains of net and sets the paths the relayer is started on.
+	build func(ctx context.Context, env networkEnv, net *runningNetwork) error
+	// setup, if set, runs once the API is served, before chains and paths can be added through it.
+	setup func(ctx context.Context, env networkEnv, net *runningNetwork) error
+}
+
+// networkEnv is what the functions of a networkRun run with.
+type networkEnv struct {
+	logger    *zap.Logger
+	t         FakeTesting
+	eRep      *testreporter.RelayerExecReporter
+	client    *dockerclient.Client
+	networkID string
+}
+
+// runningNetwork is the network served by serveNetwork.
+type runningNetwork struct {
+	// ic backs the API handlers. It only builds the chains of a started network.
+	ic         *interchaintest.Interchain
+	chains     []ibc.Chain
+	chainsByID map[string]ibc.Chain
+	relayer    ibc.Relayer
+	// relayerPaths are the IBC paths the relayer is started on.
+	relayerPaths []string
+}
+
+// serveNetwork builds or resumes the network of n and serves the API until the process is interrupted
+// or a shutdown is requested. The API, relayer and chains are then stopped and their docker resources removed,
+// unless ac.Keep is set or the shutdown asked to persist them, in which case the network is recorded to be resumed.
+func serveNetwork(ctx context.Context, n networkRun) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The first interrupt cancels ctx, which stops the build or the block wait below
+	// and shuts the network down through the deferred calls.
+	notifyInterrupt(cancel)
+
+	// Cleanup runs once ctx is cancelled, so it needs a context of its own.
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	// Set by --keep or by a shutdown request asking to persist the network.
+	var keep atomic.Bool
+	keep.Store(n.ac.Keep)
+	shutdown := func(persist bool) {
+		if persist {
+			keep.Store(true)
 		}
+		cancel()
 	}
 
-	// Build all chains & begin.
-	err = ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
-		TestName:         testName,
-		Client:           client,
-		NetworkID:        network,
-		SkipPathCreation: false,
-		// BlockDatabaseFile: interchaintest.DefaultBlockDatabaseFilepath(),
-	})
+	// Logger for ICTest functions only.
+	logger, err := InitLogger()
 	if err != nil {
-		logger.Fatal("ic.Build", zap.Error(err))
+		return err
+	}
+
+	WriteRunningChains(n.installDir, []byte("{}"))
+	defer WriteRunningChains(n.installDir, []byte("{}"))
+
+	env := networkEnv{
+		logger: logger,
+		t: FakeTesting{
+			FakeName: n.testName,
+		},
 	}
 
-	if relayer != nil && len(ibcpaths) > 0 {
-		paths := make([]string, 0, len(ibcpaths))
-		for k := range ibcpaths {
-			paths = append(paths, k)
+	// Base setup
+	rep := testreporter.NewNopReporter()
+	env.eRep = rep.RelayerExecReporter(&env.t)
+
+	env.client, env.networkID, err = n.docker(ctx, env.t)
+	if err != nil {
+		return err
+	}
+
+	net := &runningNetwork{ic: interchaintest.NewInterchain()}
+	defer net.ic.Close()
+
+	// Only a network that was fully set up is recorded. One that was resumed keeps its record otherwise,
+	// since its containers and volumes are reused.
+	var topo *topology
+	defer func() {
+		ShutdownNetwork(cleanupCtx, env.client, env.t, keep.Load())
+		if !keep.Load() {
+			RemovePersistedNetwork(n.installDir, n.name)
+			return
+		}
+		if topo == nil {
+			return
 		}
 
-		if err := relayer.StartRelayer(ctx, eRep, paths...); err != nil {
-			log.Fatal("relayer.StartRelayer", err)
+		// The topology holds the paths added while the network ran, and the config its chains.
+		record := PersistedNetwork{
+			TestName:  n.testName,
+			NetworkID: env.networkID,
+			IBCPaths:  topo.Paths(),
+			Config:    n.config,
+		}
+		if dr, ok := net.relayer.(interface{ VolumeName() string }); ok {
+			record.RelayerVolume = dr.VolumeName()
+		}
+		if err := SavePersistedNetwork(n.installDir, n.name, record); err != nil {
+			log.Println("SavePersistedNetwork", err)
+			return
 		}
+		log.Printf("Persisted the network, resume it with: local-ic resume %s\n", n.name)
+	}()
+
+	net.relayer = n.relayer(env)
+	if err := n.build(ctx, env, net); err != nil {
+		return err
+	}
 
+	if net.relayer != nil {
+		if len(net.relayerPaths) > 0 {
+			if err := net.relayer.StartRelayer(ctx, env.eRep, net.relayerPaths...); err != nil {
+				return fmt.Errorf("relayer.StartRelayer: %w", err)
+			}
+		}
+
+		// Paths added while the network runs start the relayer, even if none did on startup.
 		defer func() {
-			if err := relayer.StopRelayer(ctx, eRep); err != nil {
-				log.Fatal("relayer.StopRelayer", err)
+			if err := net.relayer.StopRelayer(cleanupCtx, env.eRep); err != nil {
+				log.Println("relayer.StopRelayer", err)
 			}
 		}()
 	}
 
-	for _, chain := range chains {
+	vals := make(map[string][]*cosmos.ChainNode)
+	net.chainsByID = make(map[string]ibc.Chain, len(net.chains))
+	for _, chain := range net.chains {
+		chainID := chain.Config().ChainID
+		net.chainsByID[chainID] = chain
 		if cosmosChain, ok := chain.(*cosmos.CosmosChain); ok {
-			chainID := cosmosChain.Config().ChainID
 			vals[chainID] = cosmosChain.Validators
 		}
 	}
 
-	// ICS provider setup
-	if len(icsProviderPaths) > 0 {
-		logger.Info("ICS provider setup", zap.Any("icsProviderPaths", icsProviderPaths))
+	topo = newTopology(logger, env.client, env.networkID, n.testName, n.installDir, env.eRep, n.config, net.chainsByID, vals, net.relayer, net.relayerPaths)
+	defer topo.Close()
 
-		for ibcPath, chain := range icsProviderPaths {
-			if provider, ok := chain.(*cosmos.CosmosChain); ok {
-				if err := provider.FinishICSProviderSetup(ctx, relayer, eRep, ibcPath); err != nil {
-					log.Fatal("FinishICSProviderSetup", err)
-				}
+	// Starts a non blocking REST server to take action on the chain.
+	r := router.NewRouter(ctx, net.ic, n.config, net.chainsByID, vals, net.relayer, n.ac.AuthKey, env.eRep, topo, n.installDir, shutdown)
+	defer serveAPI(cleanupCtx, n.config, n.ac, r)()
+
+	// Chains and paths are only added through the API once the network is set up.
+	if err := func() error {
+		topo.mu.Lock()
+		defer topo.mu.Unlock()
+
+		if n.setup != nil {
+			if err := n.setup(ctx, env, net); err != nil {
+				return err
 			}
 		}
-	}
 
-	// Starts a non blocking REST server to take action on the chain.
-	go func() {
-		cosmosChains := map[string]*cosmos.CosmosChain{}
-		for _, chain := range chains {
-			if cosmosChain, ok := chain.(*cosmos.CosmosChain); ok {
-				cosmosChains[cosmosChain.Config().ChainID] = cosmosChain
-			}
+		connections, err := GetChannelConnections(ctx, ibcPathIndexes(n.config), net.chains, net.ic, net.relayer, env.eRep)
+		if err != nil {
+			return err
 		}
 
-		r := router.NewRouter(ctx, ic, config, cosmosChains, vals, relayer, ac.AuthKey, eRep, installDir)
+		// Save to logs.json file for runtime chain information.
+		DumpChainsInfoToLogs(n.installDir, n.config, net.chains, connections)
+		return nil
+	}(); err != nil {
+		return err
+	}
 
-		config.Server = types.RestServer{
-			Host: ac.Address,
-			Port: fmt.Sprintf("%d", ac.Port),
-		}
+	log.Println("\nLocal-IC API is running on ", fmt.Sprintf("http://%s:%s", n.config.Server.Host, n.config.Server.Port))
 
-		server := fmt.Sprintf("%s:%s", config.Server.Host, config.Server.Port)
-		if err := http.ListenAndServe(server, r); err != nil {
-			log.Default().Println(err)
-		}
-	}()
+	if err := testutil.WaitForBlocks(ctx, math.MaxInt, net.chains[0]); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("WaitForBlocks: %w", err)
+	}
+	return nil
+}
 
-	AddGenesisKeysToKeyring(ctx, config, chains)
+// newRelayer builds the relayer of rlyCfg on the docker network.
+// The config is validated on start, so an unknown relayer type falls back to rly.
+func newRelayer(logger *zap.Logger, rlyCfg types.Relayer, t FakeTesting, client *dockerclient.Client, network string, opts ...interchaintestrelayer.RelayerOpt) ibc.Relayer {
+	impl, _ := rlyCfg.Implementation()
+
+	var rlyOpts []interchaintestrelayer.RelayerOpt
+	if rlyCfg.DockerImage.Repository != "" {
+		rlyOpts = append(rlyOpts, interchaintestrelayer.CustomDockerImage(
+			rlyCfg.DockerImage.Repository,
+			rlyCfg.DockerImage.Version,
+			rlyCfg.DockerImage.UidGid,
+		))
+	}
+	if len(rlyCfg.StartupFlags) > 0 {
+		rlyOpts = append(rlyOpts, interchaintestrelayer.StartupFlags(rlyCfg.StartupFlags...))
+	}
 
-	// run commands for each server after startup. Iterate chain configs
-	PostStartupCommands(ctx, config, chains)
+	rf := interchaintest.NewBuiltinRelayerFactory(impl, logger, append(rlyOpts, opts...)...)
 
-	connections := GetChannelConnections(ctx, ibcpaths, chains, ic, relayer, eRep)
+	// This also just needs the name.
+	return rf.Build(t, client, network)
+}
 
-	// Save to logs.json file for runtime chain information.
-	DumpChainsInfoToLogs(installDir, config, chains, connections)
+// notifyInterrupt calls cancel on the first interrupt or SIGTERM. A second one exits immediately.
+func notifyInterrupt(cancel context.CancelFunc) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigs
+		log.Printf("\nShutting down from %s, interrupt again to exit immediately\n", sig)
+		signal.Stop(sigs)
+		cancel()
+	}()
+}
 
-	log.Println("\nLocal-IC API is running on ", fmt.Sprintf("http://%s:%s", config.Server.Host, config.Server.Port))
+// serveAPI serves r in the background on the address of ac, which it records in config.
+// The returned function shuts the server down.
+func serveAPI(ctx context.Context, config *types.Config, ac *types.AppStartConfig, r http.Handler) func() {
+	config.Server = types.RestServer{
+		Host: ac.Address,
+		Port: fmt.Sprintf("%d", ac.Port),
+	}
 
-	if err = testutil.WaitForBlocks(ctx, math.MaxInt, chains[0]); err != nil {
-		log.Fatal("WaitForBlocks StartChain: ", err)
+	// gRPC clients of the RPC service require HTTP/2 without TLS.
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%s", config.Server.Host, config.Server.Port),
+		Handler: h2c.NewHandler(r, &http2.Server{}),
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Default().Println(err)
+		}
+	}()
+
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Println("server.Shutdown", err)
+		}
 	}
 }
 
@@ -0,0 +1,291 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+)
+
+// Actions of the steps of a scenario.
+const (
+	StepStoreContract       = "store_contract"
+	StepInstantiateContract = "instantiate_contract"
+	StepExecuteContract     = "execute_contract"
+	StepFund                = "fund"
+	StepIBCTransfer         = "ibc_transfer"
+	StepSubmitProposal      = "submit_proposal"
+	StepVote                = "vote"
+	StepQuery               = "query"
+	StepWaitBlocks          = "wait_blocks"
+	StepWaitQuery           = "wait_query"
+)
+
+// DefaultWaitQueryBlocks is the number of blocks a wait_query step polls for, unless blocks is set.
+const DefaultWaitQueryBlocks = 30
+
+// stepFields are the fields each action requires, and whether it only runs on cosmos chains.
+var stepFields = map[string]struct {
+	required []string
+	cosmos   bool
+}{
+	StepStoreContract:       {[]string{"file"}, true},
+	StepInstantiateContract: {[]string{"code_id", "msg"}, true},
+	StepExecuteContract:     {[]string{"contract", "msg"}, true},
+	StepFund:                {[]string{"address", "amount"}, false},
+	StepIBCTransfer:         {[]string{"channel", "address", "amount"}, true},
+	StepSubmitProposal:      {[]string{"proposal"}, true},
+	StepVote:                {[]string{"proposal_id", "vote"}, true},
+	StepQuery:               {[]string{"query"}, true},
+	StepWaitBlocks:          {[]string{"blocks"}, false},
+	StepWaitQuery:           {[]string{"query", "expect"}, true},
+}
+
+var (
+	// scenarioVarRe matches the ${name} references to the saved outputs of earlier steps.
+	scenarioVarRe = regexp.MustCompile(`\$\{(\w+)\}`)
+	saveNameRe    = regexp.MustCompile(`^\w+$`)
+)
+
+// ScenarioStep is a step of the scenario run once the network started, after the startup commands of the chains.
+// The output of a step is saved with Save, and later steps reference it as ${name} in any of their values.
+//
+// The output of each action is:
+//   - store_contract: the code ID.
+//   - instantiate_contract: the contract address.
+//   - execute_contract, ibc_transfer: the transaction hash.
+//   - submit_proposal: the proposal ID.
+//   - query, wait_query: the value at path of the JSON output, or the whole output without a path.
+//   - wait_blocks: the height of the chain.
+//   - fund, vote: none.
+type ScenarioStep struct {
+	// Name describes the step in the logs.
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Action  string `json:"action" yaml:"action"`
+	ChainID string `json:"chain_id" yaml:"chain_id"`
+	// Key signs the transactions of the step. Defaults to the faucet account.
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
+
+	// File is the name of a contract in the contracts directory, for store_contract.
+	File string `json:"file,omitempty" yaml:"file,omitempty"`
+	// CodeID and Admin instantiate a contract. Contracts are instantiated without an admin unless one is set.
+	CodeID string `json:"code_id,omitempty" yaml:"code_id,omitempty"`
+	Admin  string `json:"admin,omitempty" yaml:"admin,omitempty"`
+	// Contract is the address of the contract to execute.
+	Contract string `json:"contract,omitempty" yaml:"contract,omitempty"`
+	// Msg is the instantiate or execute message of a contract, as a JSON object or string.
+	Msg any `json:"msg,omitempty" yaml:"msg,omitempty"`
+	// Funds are the coins sent along an instantiate or execute message, e.g. "100ujuno".
+	Funds string `json:"funds,omitempty" yaml:"funds,omitempty"`
+
+	// Address receives the Amount of Denom with fund and ibc_transfer. Denom defaults to the denom of the chain.
+	Address string `json:"address,omitempty" yaml:"address,omitempty"`
+	Amount  string `json:"amount,omitempty" yaml:"amount,omitempty"`
+	Denom   string `json:"denom,omitempty" yaml:"denom,omitempty"`
+	// Channel and Memo of an ibc_transfer.
+	Channel string `json:"channel,omitempty" yaml:"channel,omitempty"`
+	Memo    string `json:"memo,omitempty" yaml:"memo,omitempty"`
+
+	// Proposal is the gov v1 proposal to submit: messages, metadata, deposit, title, summary and expedited.
+	Proposal any `json:"proposal,omitempty" yaml:"proposal,omitempty"`
+	// ProposalID is the proposal to vote on.
+	ProposalID string `json:"proposal_id,omitempty" yaml:"proposal_id,omitempty"`
+	// Vote is cast by all validators: yes, no, no_with_veto or abstain. It is optional for submit_proposal.
+	Vote string `json:"vote,omitempty" yaml:"vote,omitempty"`
+
+	// Query is a query command of the chain binary, e.g. "bank balances juno1...".
+	Query string `json:"query,omitempty" yaml:"query,omitempty"`
+	// Path is the dot separated path of the value in the JSON output of the query, e.g. "balances.0.amount".
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// Expect is the value a wait_query step waits for.
+	Expect string `json:"expect,omitempty" yaml:"expect,omitempty"`
+	// Blocks is the number of blocks to wait for, or the most a wait_query step polls for.
+	Blocks int `json:"blocks,omitempty" yaml:"blocks,omitempty"`
+
+	// Save names the variable the output of the step is saved to.
+	Save string `json:"save,omitempty" yaml:"save,omitempty"`
+}
+
+// ValidateScenario checks the steps of the scenario against the chains of the config,
+// and that steps only reference variables saved by earlier steps.
+func (cfg *Config) ValidateScenario() error {
+	chainTypes := make(map[string]string, len(cfg.Chains))
+	for _, c := range cfg.Chains {
+		chainTypes[c.ChainID] = c.ChainType
+	}
+
+	saved := make(map[string]bool)
+	for i, step := range cfg.Scenario {
+		if err := step.validate(chainTypes, saved); err != nil {
+			return fmt.Errorf("scenario step %d (%s): %w", i, step.Description(), err)
+		}
+		if step.Save != "" {
+			saved[step.Save] = true
+		}
+	}
+	return nil
+}
+
+func (s ScenarioStep) validate(chainTypes map[string]string, saved map[string]bool) error {
+	fields, ok := stepFields[s.Action]
+	if !ok {
+		return fmt.Errorf("unknown action %q", s.Action)
+	}
+
+	chainType, ok := chainTypes[s.ChainID]
+	if !ok {
+		return fmt.Errorf("chain %q is not in the config", s.ChainID)
+	}
+	if fields.cosmos && chainType != "cosmos" {
+		return fmt.Errorf("%s is only supported on cosmos chains, not %s", s.Action, chainType)
+	}
+
+	values := map[string]bool{
+		"file":        s.File != "",
+		"code_id":     s.CodeID != "",
+		"contract":    s.Contract != "",
+		"msg":         s.Msg != nil,
+		"address":     s.Address != "",
+		"amount":      s.Amount != "",
+		"channel":     s.Channel != "",
+		"proposal":    s.Proposal != nil,
+		"proposal_id": s.ProposalID != "",
+		"vote":        s.Vote != "",
+		"query":       s.Query != "",
+		"expect":      s.Expect != "",
+		"blocks":      s.Blocks > 0,
+	}
+	for _, f := range fields.required {
+		if !values[f] {
+			return fmt.Errorf("'%s' is required", f)
+		}
+	}
+
+	if s.Save != "" && !saveNameRe.MatchString(s.Save) {
+		return fmt.Errorf("save name %q must only contain letters, digits and underscores", s.Save)
+	}
+
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	for _, m := range scenarioVarRe.FindAllStringSubmatch(string(bz), -1) {
+		if !saved[m[1]] {
+			return fmt.Errorf("${%s} is not saved by an earlier step", m[1])
+		}
+	}
+	return nil
+}
+
+// Description returns the name of the step, or its action without one.
+func (s ScenarioStep) Description() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Action
+}
+
+// KeyName returns the key signing the transactions of the step.
+func (s ScenarioStep) KeyName() string {
+	if s.Key != "" {
+		return s.Key
+	}
+	return "faucet"
+}
+
+// Expand returns the step with the ${name} references replaced by the values of vars.
+func (s ScenarioStep) Expand(vars map[string]string) (ScenarioStep, error) {
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return s, err
+	}
+
+	var missing []string
+	expanded := scenarioVarRe.ReplaceAllStringFunc(string(bz), func(ref string) string {
+		name := scenarioVarRe.FindStringSubmatch(ref)[1]
+		v, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+			return ref
+		}
+		// The value is inside a JSON string, so it is escaped as one.
+		escaped, _ := json.Marshal(v)
+		return string(escaped[1 : len(escaped)-1])
+	})
+	if len(missing) > 0 {
+		return s, fmt.Errorf("variables %v are not saved", missing)
+	}
+
+	var out ScenarioStep
+	if err := json.Unmarshal([]byte(expanded), &out); err != nil {
+		return s, err
+	}
+	return out, nil
+}
+
+// MsgJSON returns the contract message of the step as JSON.
+func (s ScenarioStep) MsgJSON() (string, error) {
+	if msg, ok := s.Msg.(string); ok {
+		return msg, nil
+	}
+	bz, err := json.Marshal(s.Msg)
+	return string(bz), err
+}
+
+// ProposalV1 returns the gov v1 proposal of the step.
+func (s ScenarioStep) ProposalV1() (cosmos.TxProposalv1, error) {
+	var prop cosmos.TxProposalv1
+	bz, err := json.Marshal(s.Proposal)
+	if err != nil {
+		return prop, err
+	}
+	if err := json.Unmarshal(bz, &prop); err != nil {
+		return prop, fmt.Errorf("invalid proposal: %w", err)
+	}
+	return prop, nil
+}
+
+// QueryValue returns the value at the dot separated path of the JSON output. Numeric path elements
+// index arrays. Strings are returned as they are, other values as JSON. Without a path, the trimmed
+// output is returned.
+func QueryValue(output []byte, path string) (string, error) {
+	if path == "" {
+		return strings.TrimSpace(string(output)), nil
+	}
+
+	var v any
+	if err := json.Unmarshal(output, &v); err != nil {
+		return "", fmt.Errorf("query output is not JSON: %w", err)
+	}
+
+	for _, elem := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = node[elem]; !ok {
+				return "", fmt.Errorf("%s: %q not found", path, elem)
+			}
+		case []any:
+			i, err := strconv.Atoi(elem)
+			if err != nil || i < 0 || i >= len(node) {
+				return "", fmt.Errorf("%s: index %q out of range of %d elements", path, elem, len(node))
+			}
+			v = node[i]
+		default:
+			return "", fmt.Errorf("%s: %q is not an object or array", path, elem)
+		}
+	}
+
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	if v == nil {
+		return "", errors.New(path + ": value is null")
+	}
+	bz, err := json.Marshal(v)
+	return string(bz), err
+}
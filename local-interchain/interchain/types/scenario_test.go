@@ -0,0 +1,124 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestScenario(t *testing.T) {
+	cfg := Config{
+		Chains: []Chain{
+			{ChainID: "localjuno-1", ChainType: "cosmos"},
+			{ChainID: "31337", ChainType: "ethereum"},
+		},
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		cfg := cfg
+		cfg.Scenario = []ScenarioStep{
+			{Action: StepStoreContract, ChainID: "localjuno-1", File: "cw20.wasm", Save: "code_id"},
+			{Action: StepInstantiateContract, ChainID: "localjuno-1", CodeID: "${code_id}", Msg: map[string]any{"name": "token"}, Save: "cw20"},
+			{Action: StepFund, ChainID: "31337", Address: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", Amount: "100"},
+			{Action: StepWaitQuery, ChainID: "localjuno-1", Query: "wasm contract-state smart ${cw20} {}", Expect: "token"},
+		}
+		require.NoError(t, cfg.ValidateScenario())
+
+		for _, tc := range []struct {
+			step ScenarioStep
+			err  string
+		}{
+			{ScenarioStep{Action: "deploy", ChainID: "localjuno-1"}, `unknown action "deploy"`},
+			{ScenarioStep{Action: StepFund, ChainID: "localjuno-2", Address: "a", Amount: "1"}, `chain "localjuno-2" is not in the config`},
+			{ScenarioStep{Action: StepStoreContract, ChainID: "31337", File: "a.wasm"}, "only supported on cosmos chains"},
+			{ScenarioStep{Action: StepIBCTransfer, ChainID: "31337", Channel: "channel-0", Address: "a", Amount: "1"}, "only supported on cosmos chains"},
+			{ScenarioStep{Action: StepIBCTransfer, ChainID: "localjuno-1", Address: "a", Amount: "1"}, "'channel' is required"},
+			{ScenarioStep{Action: StepVote, ChainID: "localjuno-1", ProposalID: "${prop}", Vote: "yes"}, "${prop} is not saved by an earlier step"},
+			{ScenarioStep{Action: StepWaitBlocks, ChainID: "localjuno-1", Blocks: 1, Save: "a-b"}, "save name"},
+		} {
+			cfg.Scenario = []ScenarioStep{tc.step}
+			require.ErrorContains(t, cfg.ValidateScenario(), tc.err)
+		}
+
+		// Variables are only available to the steps after the one saving them.
+		cfg.Scenario = []ScenarioStep{
+			{Action: StepQuery, ChainID: "localjuno-1", Query: "bank balances ${addr}", Save: "addr"},
+		}
+		require.ErrorContains(t, cfg.ValidateScenario(), "scenario step 0 (query): ${addr} is not saved")
+	})
+
+	t.Run("expand", func(t *testing.T) {
+		step := ScenarioStep{
+			Action:   StepExecuteContract,
+			Contract: "${cw20}",
+			Msg:      map[string]any{"transfer": map[string]any{"recipient": "${user}", "amount": "5"}},
+		}
+
+		got, err := step.Expand(map[string]string{"cw20": "juno1contract", "user": `juno1"user`})
+		require.NoError(t, err)
+		require.Equal(t, "juno1contract", got.Contract)
+		msg, err := got.MsgJSON()
+		require.NoError(t, err)
+		require.JSONEq(t, `{"transfer": {"recipient": "juno1\"user", "amount": "5"}}`, msg)
+
+		_, err = step.Expand(map[string]string{"cw20": "juno1contract"})
+		require.ErrorContains(t, err, "[user]")
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var c Config
+		require.NoError(t, yaml.Unmarshal([]byte(`
+scenario:
+  - action: submit_proposal
+    chain_id: localjuno-1
+    vote: "yes"
+    proposal:
+      title: Text
+      deposit: 10000000ujuno
+      messages:
+        - {"@type": "/cosmos.gov.v1.MsgExecLegacyContent"}
+    save: prop
+`), &c))
+
+		require.Len(t, c.Scenario, 1)
+		prop, err := c.Scenario[0].ProposalV1()
+		require.NoError(t, err)
+		require.Equal(t, "Text", prop.Title)
+		require.Equal(t, "10000000ujuno", prop.Deposit)
+		require.Len(t, prop.Messages, 1)
+		require.JSONEq(t, `{"@type": "/cosmos.gov.v1.MsgExecLegacyContent"}`, string(prop.Messages[0]))
+	})
+
+	t.Run("query value", func(t *testing.T) {
+		out := []byte(`{"balances": [{"denom": "ujuno", "amount": "100"}], "pagination": {"total": 1}}`)
+
+		for path, want := range map[string]string{
+			"balances.0.amount": "100",
+			"pagination.total":  "1",
+			"balances.0":        `{"amount":"100","denom":"ujuno"}`,
+			"":                  string(out),
+		} {
+			got, err := QueryValue(out, path)
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+
+		for _, path := range []string{"balances.1", "balances.x", "pagination.next", "pagination.total.x"} {
+			_, err := QueryValue(out, path)
+			require.Error(t, err, path)
+		}
+
+		_, err := QueryValue([]byte("not json"), "a")
+		require.Error(t, err)
+	})
+
+	t.Run("string msg", func(t *testing.T) {
+		var step ScenarioStep
+		require.NoError(t, json.Unmarshal([]byte(`{"msg": "{\"count\": 0}"}`), &step))
+		msg, err := step.MsgJSON()
+		require.NoError(t, err)
+		require.Equal(t, `{"count": 0}`, msg)
+	})
+}
@@ -17,6 +17,8 @@ type Config struct {
 	Chains  []Chain    `json:"chains" yaml:"chains"`
 	Relayer Relayer    `json:"relayer" yaml:"relayer"`
 	Server  RestServer `json:"server" yaml:"server"`
+	// Scenario runs once the network started, see ScenarioStep.
+	Scenario []ScenarioStep `json:"scenario,omitempty" yaml:"scenario,omitempty"`
 }
 
 type AppStartConfig struct {
@@ -25,6 +27,7 @@ type AppStartConfig struct {
 	Cfg     *Config
 	Relayer Relayer
 	AuthKey string // optional password for API interaction
+	Keep    bool   // keep stopped containers, volumes and networks on shutdown
 }
 
 type RestServer struct {
@@ -32,11 +35,86 @@ type RestServer struct {
 	Port string `json:"port" yaml:"port"`
 }
 
+// Relayer types selectable in the config.
+const (
+	RelayerRly        = "rly"
+	RelayerHermes     = "hermes"
+	RelayerHyperspace = "hyperspace"
+)
+
 type Relayer struct {
+	// Type is the relayer implementation, one of rly, hermes or hyperspace. Defaults to rly.
+	Type         string          `json:"type,omitempty" yaml:"type,omitempty"`
 	DockerImage  ibc.DockerImage `json:"docker_image" yaml:"docker_image"`
 	StartupFlags []string        `json:"startup_flags" yaml:"startup_flags"`
 }
 
+// Implementation returns the interchaintest relayer implementation of the relayer type.
+func (r Relayer) Implementation() (ibc.RelayerImplementation, error) {
+	switch r.Type {
+	case "", RelayerRly:
+		return ibc.CosmosRly, nil
+	case RelayerHermes:
+		return ibc.Hermes, nil
+	case RelayerHyperspace:
+		return ibc.Hyperspace, nil
+	default:
+		return 0, fmt.Errorf("unknown relayer type %q, expected one of %s, %s or %s", r.Type, RelayerRly, RelayerHermes, RelayerHyperspace)
+	}
+}
+
+// Override returns r with the non-empty fields of o applied on top.
+func (r Relayer) Override(o Relayer) Relayer {
+	if o.Type != "" {
+		r.Type = o.Type
+	}
+	if o.DockerImage.Repository != "" {
+		r.DockerImage.Repository = o.DockerImage.Repository
+	}
+	if o.DockerImage.Version != "" {
+		r.DockerImage.Version = o.DockerImage.Version
+	}
+	if o.DockerImage.UidGid != "" {
+		r.DockerImage.UidGid = o.DockerImage.UidGid
+	}
+	if o.StartupFlags != nil {
+		r.StartupFlags = o.StartupFlags
+	}
+	return r
+}
+
+// WithDefaults fills the unset fields of an rly relayer with the image and startup flags local-ic always used.
+// Hermes and Hyperspace fall back to the interchaintest default image of their type, unless one is set.
+func (r Relayer) WithDefaults() Relayer {
+	if r.Type == "" {
+		r.Type = RelayerRly
+	}
+	if r.Type != RelayerRly {
+		if r.DockerImage.Repository != "" && r.DockerImage.Version == "" {
+			r.DockerImage.Version = "latest"
+		}
+		// Both images run as 1000:1000 by default.
+		if r.DockerImage.Repository != "" && r.DockerImage.UidGid == "" {
+			r.DockerImage.UidGid = "1000:1000"
+		}
+		return r
+	}
+
+	if r.DockerImage.Repository == "" {
+		r.DockerImage.Repository = "ghcr.io/cosmos/relayer"
+	}
+	if r.DockerImage.Version == "" {
+		r.DockerImage.Version = "latest"
+	}
+	if r.DockerImage.UidGid == "" {
+		r.DockerImage.UidGid = "100:1000"
+	}
+	if r.StartupFlags == nil {
+		r.StartupFlags = []string{"--block-history=100"}
+	}
+	return r
+}
+
 type IBCChannel struct {
 	ChainID string             `json:"chain_id" yaml:"chain_id"`
 	Channel *ibc.ChannelOutput `json:"channel" yaml:"channel"`
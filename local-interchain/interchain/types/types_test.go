@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+func TestRelayer(t *testing.T) {
+	cfg := Relayer{Type: RelayerHermes, DockerImage: ibc.DockerImage{Repository: "ghcr.io/informalsystems/hermes"}}
+
+	t.Run("override", func(t *testing.T) {
+		r := cfg.Override(Relayer{DockerImage: ibc.DockerImage{Version: "1.8.0"}})
+		require.Equal(t, RelayerHermes, r.Type)
+		require.Equal(t, ibc.DockerImage{Repository: "ghcr.io/informalsystems/hermes", Version: "1.8.0"}, r.DockerImage)
+		require.Nil(t, r.StartupFlags)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		require.Equal(t, Relayer{
+			Type:         RelayerRly,
+			DockerImage:  ibc.DockerImage{Repository: "ghcr.io/cosmos/relayer", Version: "latest", UidGid: "100:1000"},
+			StartupFlags: []string{"--block-history=100"},
+		}, Relayer{}.WithDefaults())
+
+		r := cfg.WithDefaults()
+		require.Equal(t, ibc.DockerImage{Repository: "ghcr.io/informalsystems/hermes", Version: "latest", UidGid: "1000:1000"}, r.DockerImage)
+		require.Nil(t, r.StartupFlags)
+	})
+
+	t.Run("implementation", func(t *testing.T) {
+		for typ, impl := range map[string]ibc.RelayerImplementation{
+			"":                ibc.CosmosRly,
+			RelayerRly:        ibc.CosmosRly,
+			RelayerHermes:     ibc.Hermes,
+			RelayerHyperspace: ibc.Hyperspace,
+		} {
+			got, err := Relayer{Type: typ}.Implementation()
+			require.NoError(t, err)
+			require.Equal(t, impl, got)
+		}
+
+		_, err := Relayer{Type: "go-relayer"}.Implementation()
+		require.Error(t, err)
+	})
+}
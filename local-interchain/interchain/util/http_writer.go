@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/json"
 	"log"
 	"net/http"
 )
@@ -14,3 +15,23 @@ func Write(w http.ResponseWriter, bz []byte) {
 func WriteError(w http.ResponseWriter, err error) {
 	Write(w, []byte(`{"error": "`+err.Error()+`"}`))
 }
+
+// WriteJSON writes v as the JSON body of a response with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		WriteErrorStatus(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	Write(w, bz)
+}
+
+// WriteErrorStatus writes err as a JSON error response with the given status code.
+func WriteErrorStatus(w http.ResponseWriter, status int, err error) {
+	bz, _ := json.Marshal(map[string]string{"error": err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	Write(w, bz)
+}
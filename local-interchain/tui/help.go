@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type keyBinding struct {
+	Key  string // Single key or combination of keys.
+	Help string // Very short help text describing the key's action.
+}
+
+var baseHelpKeys = []keyBinding{
+	{"esc", "go back"},
+	{"ctl+c", "exit"},
+}
+
+func bindingsWithBase(bindings ...[]keyBinding) []keyBinding {
+	var all []keyBinding
+	for i := range bindings {
+		all = append(all, bindings[i]...)
+	}
+	return append(all, baseHelpKeys...)
+}
+
+var (
+	tableNavKeys = []keyBinding{
+		{fmt.Sprintf("%c/k", tcell.RuneUArrow), "previous chain"},
+		{fmt.Sprintf("%c/j", tcell.RuneDArrow), "next chain"},
+	}
+
+	keyMap = map[mainContent][]keyBinding{
+		dashboardMain: bindingsWithBase([]keyBinding{
+			{"f", "faucet"},
+			{"r", "restart relayer"},
+			{"c", "reload channels"},
+		}, tableNavKeys),
+		faucetMain: bindingsWithBase([]keyBinding{
+			{"tab", "next field"},
+			{"enter", "press button"},
+		}),
+		errorModalMain: bindingsWithBase(nil),
+	}
+)
+
+type helpView struct {
+	*tview.Table
+}
+
+func newHelpView() *helpView {
+	tbl := tview.NewTable().SetBorders(false)
+	tbl.SetBorder(false)
+	return &helpView{tbl}
+}
+
+// Replace serves as a hook to clear all keys and update the help table view with new keys.
+func (view *helpView) Replace(keys []keyBinding) *helpView {
+	view.Table.Clear()
+	keyCell := func(s string) *tview.TableCell {
+		return tview.NewTableCell("<" + s + ">").
+			SetTextColor(tcell.ColorBlue)
+	}
+	textCell := func(s string) *tview.TableCell {
+		return tview.NewTableCell(s).
+			SetStyle(textStyle.Attributes(tcell.AttrDim))
+	}
+	var (
+		row int
+		col int
+	)
+	for _, binding := range keys {
+		// Only allow 5 help items per row or else help items will not be visible.
+		if row > 0 && row%5 == 0 {
+			row = 0
+			col += 2
+		}
+		view.Table.SetCell(row, col, keyCell(binding.Key))
+		view.Table.SetCell(row, col+1, textCell(binding.Help))
+		row++
+	}
+	return view
+}
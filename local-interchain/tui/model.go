@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/rivo/tview"
+	v1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=mainContent
+
+// mainContent is the primary content for user interaction in the UI akin to html <main>.
+type mainContent int
+
+const (
+	dashboardMain mainContent = iota
+	faucetMain
+	errorModalMain
+)
+
+type mainStack []mainContent
+
+func (stack mainStack) Push(s mainContent) []mainContent { return append(stack, s) }
+func (stack mainStack) Current() mainContent             { return stack[len(stack)-1] }
+func (stack mainStack) Pop() []mainContent               { return stack[:len(stack)-1] }
+
+const (
+	// maxTxs is the number of recent transactions shown for the selected chain.
+	maxTxs = 50
+	// maxLogLines is the number of log lines kept for the selected chain.
+	maxLogLines = 200
+)
+
+// Service reads and drives a running network. The client returned by (*client.Client).RPC implements it.
+type Service interface {
+	Info(context.Context, *connect.Request[v1.InfoRequest]) (*connect.Response[v1.InfoResponse], error)
+	Faucet(context.Context, *connect.Request[v1.FaucetRequest]) (*connect.Response[v1.FaucetResponse], error)
+	StartRelayer(context.Context, *connect.Request[v1.StartRelayerRequest]) (*connect.Response[v1.StartRelayerResponse], error)
+	StopRelayer(context.Context, *connect.Request[v1.StopRelayerRequest]) (*connect.Response[v1.StopRelayerResponse], error)
+	GetChannels(context.Context, *connect.Request[v1.GetChannelsRequest]) (*connect.Response[v1.GetChannelsResponse], error)
+	StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest]) (*connect.ServerStreamForClient[v1.StreamBlocksResponse], error)
+	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error)
+}
+
+// tx is a transaction of a block of the selected chain.
+type tx struct {
+	Height int64
+	Time   string
+	Hash   string
+}
+
+// Model encapsulates state that updates a view.
+type Model struct {
+	// ctx bounds the requests and streams of the model.
+	ctx     context.Context
+	svc     Service
+	apiAddr string
+
+	info *v1.InfoResponse
+	// chainID is the ID of the selected chain.
+	chainID  string
+	channels []*v1.Channel
+	// txs are the recent transactions of the selected chain, newest first.
+	txs []tx
+	// stopStreams cancels the block and log streams of the selected chain.
+	stopStreams context.CancelFunc
+	status      string
+
+	layout *tview.Flex
+	views  views
+
+	// stack keeps tracks of primary content pushed and popped
+	stack mainStack
+
+	// queue runs f on the main goroutine and redraws, see (*tview.Application).QueueUpdateDraw.
+	queue func(f func())
+	// spawn runs f in the background.
+	spawn func(f func())
+	// focus gives the keyboard focus to p.
+	focus func(p tview.Primitive)
+}
+
+// views are the views of the dashboard the model renders into.
+type views struct {
+	help     *helpView
+	network  *tview.Table
+	chains   *tview.Table
+	nodes    *tview.Table
+	channels *tview.Table
+	txs      *tview.Table
+	logs     *tview.TextView
+}
+
+// NewModel returns a valid *Model showing the network served by svc on apiAddr.
+// The model does not fetch anything until Refresh or Run is called.
+func NewModel(ctx context.Context, svc Service, apiAddr string) *Model {
+	m := &Model{
+		ctx:     ctx,
+		svc:     svc,
+		apiAddr: apiAddr,
+		stack:   mainStack{dashboardMain},
+		queue:   func(f func()) { f() },
+		spawn:   func(f func()) { go f() },
+		focus:   func(tview.Primitive) {},
+	}
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow)
+	flex.SetBackgroundColor(backgroundColor).SetBorder(false)
+	// Setting fixed size keeps the header height stable, so it will show all help keys.
+	flex.AddItem(headerView(m), 6, 1, false)
+
+	// The primary view is a page view to act like a stack where we can push and pop views.
+	pages := tview.NewPages()
+	pages.AddAndSwitchToPage(m.stack[0].String(), dashboardView(m), true)
+	flex.AddItem(pages, 0, 10, true)
+
+	m.layout = flex
+	m.renderNetwork()
+
+	return m
+}
+
+// RootView is a root view for a tview.Application.
+func (m *Model) RootView() *tview.Flex {
+	return m.layout
+}
@@ -0,0 +1,15 @@
+package tui
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModel_RootView(t *testing.T) {
+	m := NewModel(context.Background(), newMockService(), "http://127.0.0.1:8080")
+	view := m.RootView()
+	require.NotNil(t, view)
+	require.Greater(t, view.GetItemCount(), 0)
+}
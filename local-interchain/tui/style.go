@@ -0,0 +1,14 @@
+package tui
+
+import "github.com/gdamore/tcell/v2"
+
+const (
+	backgroundColor = tcell.ColorBlack
+	textColor       = tcell.ColorWhite
+	titleColor      = tcell.ColorDarkOrange
+	errorTextColor  = tcell.ColorRed
+)
+
+var (
+	textStyle = tcell.Style{}.Foreground(textColor)
+)
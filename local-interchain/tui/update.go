@@ -0,0 +1,285 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	v1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+)
+
+// Run shows the dashboard in app until it stops, refreshing the network every interval.
+// Responses are rendered through (*tview.Application).QueueUpdateDraw.
+func (m *Model) Run(app *tview.Application, interval time.Duration) error {
+	m.queue = func(f func()) { app.QueueUpdateDraw(f) }
+	m.focus = func(p tview.Primitive) { app.SetFocus(p) }
+	defer m.selectChain("")
+
+	go m.poll(interval)
+	return app.SetInputCapture(m.Update()).SetRoot(m.RootView(), true).SetFocus(m.views.chains).Run()
+}
+
+// poll refreshes the network every interval until the context of the model is done.
+func (m *Model) poll(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		m.Refresh()
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh fetches the chains, heights and relayer of the network and renders them.
+// It may be called from any goroutine.
+func (m *Model) Refresh() {
+	res, err := m.svc.Info(m.ctx, connect.NewRequest(&v1.InfoRequest{}))
+	if err != nil {
+		m.queue(func() { m.setStatus(fmt.Sprintf("info: %v", err)) })
+		return
+	}
+	m.queue(func() {
+		m.info = res.Msg
+		m.renderNetwork()
+		m.renderChains()
+		m.renderChain()
+	})
+}
+
+// Update should be the argument for *(tview.Application).SetInputCapture.
+// The Model potentially updates view state based on the event.
+// Update must be called from the main goroutine. Otherwise, view updates will not render or cause data races.
+// Per tview documentation, return nil to stop event propagation.
+func (m *Model) Update() func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyESC:
+			if len(m.stack) > 1 { // Stack must be at least 1, so we don't remove all main content views.
+				m.popMainView()
+				return nil
+			}
+
+		case event.Rune() == 'f' && m.stack.Current() == dashboardMain:
+			c := m.chain()
+			if c == nil {
+				return nil
+			}
+			if !slices.Contains(c.Actions, handlers.ActionFaucet) {
+				m.pushErrorModal(fmt.Errorf("the faucet is not supported on %s chains", c.Type))
+				return nil
+			}
+			chainID := c.ChainId
+			m.pushMainView(faucetMain, faucetFormView(chainID, c.Denom, func(address, amount string) {
+				m.popMainView()
+				m.faucet(chainID, address, amount)
+			}, m.popMainView))
+			return nil
+
+		case event.Rune() == 'r' && m.stack.Current() == dashboardMain:
+			m.restartRelayer()
+			return nil
+
+		case event.Rune() == 'c' && m.stack.Current() == dashboardMain:
+			m.loadChannels(m.chainID)
+			return nil
+		}
+
+		return event
+	}
+}
+
+// selectChain shows the chain and streams its blocks and logs, stopping the streams of the previous chain.
+func (m *Model) selectChain(chainID string) {
+	if chainID == m.chainID {
+		return
+	}
+	if m.stopStreams != nil {
+		m.stopStreams()
+		m.stopStreams = nil
+	}
+	m.chainID = chainID
+	m.channels = nil
+	m.txs = nil
+	m.views.logs.Clear()
+	m.renderChain()
+
+	c := m.chain()
+	if c == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.stopStreams = cancel
+	if slices.Contains(c.Actions, handlers.ActionStreamBlocks) {
+		m.spawn(func() { m.streamBlocks(ctx, chainID) })
+	}
+	if slices.Contains(c.Actions, handlers.ActionStreamLogs) {
+		m.spawn(func() { m.streamLogs(ctx, chainID) })
+	}
+	m.loadChannels(chainID)
+}
+
+// streamBlocks adds the transactions of the blocks of the chain to the recent transactions until ctx is done.
+func (m *Model) streamBlocks(ctx context.Context, chainID string) {
+	stream, err := m.svc.StreamBlocks(ctx, connect.NewRequest(&v1.StreamBlocksRequest{ChainId: chainID}))
+	if err != nil {
+		m.queue(func() { m.setStatus(fmt.Sprintf("stream blocks: %v", err)) })
+		return
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		b := stream.Msg().Block
+		m.queue(func() {
+			if chainID != m.chainID {
+				return
+			}
+			for _, hash := range b.TxHashes {
+				m.txs = append([]tx{{Height: b.Height, Time: b.Time, Hash: hash}}, m.txs...)
+			}
+			m.txs = m.txs[:min(len(m.txs), maxTxs)]
+			m.renderChain()
+		})
+	}
+	if err := stream.Err(); err != nil && ctx.Err() == nil {
+		m.queue(func() { m.setStatus(fmt.Sprintf("stream blocks: %v", err)) })
+	}
+}
+
+// streamLogs writes the log lines of the first node of the chain to the logs view until ctx is done.
+func (m *Model) streamLogs(ctx context.Context, chainID string) {
+	stream, err := m.svc.StreamLogs(ctx, connect.NewRequest(&v1.StreamLogsRequest{ChainId: chainID}))
+	if err != nil {
+		m.queue(func() { m.setStatus(fmt.Sprintf("stream logs: %v", err)) })
+		return
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		l := stream.Msg().Line
+		m.queue(func() {
+			if chainID != m.chainID {
+				return
+			}
+			if l.Message != "" {
+				fmt.Fprintf(m.views.logs, "%s %s\n", l.Level, l.Message)
+			} else {
+				fmt.Fprintln(m.views.logs, l.Raw)
+			}
+			m.views.logs.ScrollToEnd()
+		})
+	}
+	if err := stream.Err(); err != nil && ctx.Err() == nil {
+		m.queue(func() { m.setStatus(fmt.Sprintf("stream logs: %v", err)) })
+	}
+}
+
+// loadChannels fetches the channels of the chain from the relayer, if the network has one.
+func (m *Model) loadChannels(chainID string) {
+	if m.info == nil || m.info.RelayerType == "" || chainID == "" {
+		return
+	}
+	m.spawn(func() {
+		res, err := m.svc.GetChannels(m.ctx, connect.NewRequest(&v1.GetChannelsRequest{ChainId: chainID}))
+		m.queue(func() {
+			if err != nil {
+				m.setStatus(fmt.Sprintf("channels: %v", err))
+				return
+			}
+			if chainID == m.chainID {
+				m.channels = res.Msg.Channels
+				m.renderChain()
+			}
+		})
+	})
+}
+
+// faucet sends amount of the chain denom to address.
+func (m *Model) faucet(chainID, address, amount string) {
+	m.runAction(fmt.Sprintf("faucet %s", chainID), func(ctx context.Context) error {
+		_, err := m.svc.Faucet(ctx, connect.NewRequest(&v1.FaucetRequest{ChainId: chainID, Address: address, Amount: amount}))
+		return err
+	})
+}
+
+// restartRelayer stops the relayer and starts it again on every path of the network.
+func (m *Model) restartRelayer() {
+	if m.info == nil || m.info.RelayerType == "" {
+		m.pushErrorModal(errors.New("relayer not configured for this setup"))
+		return
+	}
+	paths := make([]string, len(m.info.Paths))
+	for i, p := range m.info.Paths {
+		paths[i] = p.Name
+	}
+	chainID := m.chainID
+	m.runAction("restart relayer", func(ctx context.Context) error {
+		if _, err := m.svc.StopRelayer(ctx, connect.NewRequest(&v1.StopRelayerRequest{})); err != nil {
+			return err
+		}
+		_, err := m.svc.StartRelayer(ctx, connect.NewRequest(&v1.StartRelayerRequest{Paths: paths}))
+		return err
+	}, func() {
+		m.loadChannels(chainID)
+		m.spawn(m.Refresh)
+	})
+}
+
+// runAction runs do in the background, showing its progress in the status line,
+// or an error modal if it fails. The then functions are called on success.
+func (m *Model) runAction(name string, do func(ctx context.Context) error, then ...func()) {
+	m.setStatus(name + "...")
+	m.spawn(func() {
+		err := do(m.ctx)
+		m.queue(func() {
+			if err != nil {
+				m.setStatus(name + ": failed")
+				m.pushErrorModal(fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			m.setStatus(name + ": done")
+			for _, f := range then {
+				f()
+			}
+		})
+	})
+}
+
+func (m *Model) setStatus(status string) {
+	m.status = status
+	m.renderNetwork()
+}
+
+func (m *Model) mainContentView() *tview.Pages {
+	return m.layout.GetItem(1).(*tview.Pages)
+}
+
+func (m *Model) pushMainView(main mainContent, view tview.Primitive) {
+	m.stack = m.stack.Push(main)
+	m.mainContentView().AddAndSwitchToPage(main.String(), view, true)
+	m.views.help.Replace(keyMap[main])
+	m.focus(view)
+}
+
+func (m *Model) popMainView() {
+	m.mainContentView().RemovePage(m.stack.Current().String())
+	m.stack = m.stack.Pop()
+	m.views.help.Replace(keyMap[m.stack.Current()])
+	_, view := m.mainContentView().GetFrontPage()
+	if m.stack.Current() == dashboardMain {
+		view = m.views.chains
+	}
+	m.focus(view)
+}
+
+func (m *Model) pushErrorModal(err error) {
+	m.pushMainView(errorModalMain, errorModalView(err))
+}
@@ -0,0 +1,202 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+	"github.com/strangelove-ventures/interchaintest/local-interchain/interchain/handlers"
+)
+
+var (
+	escKey   = tcell.NewEventKey(tcell.KeyESC, ' ', 0)
+	enterKey = tcell.NewEventKey(tcell.KeyEnter, ' ', 0)
+)
+
+func runeKey(c rune) *tcell.EventKey {
+	return tcell.NewEventKey(tcell.KeyRune, c, 0)
+}
+
+// draw is necessary for some of the below tests to get default behavior such as selecting the first available
+// row in a *tview.Table.
+func draw(view tview.Primitive) {
+	view.Draw(tcell.NewSimulationScreen(""))
+}
+
+var errStream = connect.NewError(connect.CodeUnimplemented, errors.New("streams are not mocked"))
+
+type mockService struct {
+	InfoRes  *v1.InfoResponse
+	Channels []*v1.Channel
+	Err      error
+
+	GotFaucet   *v1.FaucetRequest
+	GotChannels []string
+	Calls       []string
+}
+
+func (m *mockService) Info(ctx context.Context, req *connect.Request[v1.InfoRequest]) (*connect.Response[v1.InfoResponse], error) {
+	return connect.NewResponse(m.InfoRes), nil
+}
+
+func (m *mockService) Faucet(ctx context.Context, req *connect.Request[v1.FaucetRequest]) (*connect.Response[v1.FaucetResponse], error) {
+	m.GotFaucet = req.Msg
+	return connect.NewResponse(&v1.FaucetResponse{}), m.Err
+}
+
+func (m *mockService) StartRelayer(ctx context.Context, req *connect.Request[v1.StartRelayerRequest]) (*connect.Response[v1.StartRelayerResponse], error) {
+	m.Calls = append(m.Calls, "start", req.Msg.Paths[0])
+	return connect.NewResponse(&v1.StartRelayerResponse{}), nil
+}
+
+func (m *mockService) StopRelayer(ctx context.Context, req *connect.Request[v1.StopRelayerRequest]) (*connect.Response[v1.StopRelayerResponse], error) {
+	m.Calls = append(m.Calls, "stop")
+	return connect.NewResponse(&v1.StopRelayerResponse{}), m.Err
+}
+
+func (m *mockService) GetChannels(ctx context.Context, req *connect.Request[v1.GetChannelsRequest]) (*connect.Response[v1.GetChannelsResponse], error) {
+	m.GotChannels = append(m.GotChannels, req.Msg.ChainId)
+	return connect.NewResponse(&v1.GetChannelsResponse{Channels: m.Channels}), nil
+}
+
+func (m *mockService) StreamBlocks(ctx context.Context, req *connect.Request[v1.StreamBlocksRequest]) (*connect.ServerStreamForClient[v1.StreamBlocksResponse], error) {
+	return nil, errStream
+}
+
+func (m *mockService) StreamLogs(ctx context.Context, req *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error) {
+	return nil, errStream
+}
+
+func newMockService() *mockService {
+	return &mockService{
+		InfoRes: &v1.InfoResponse{
+			Chains: []*v1.Chain{
+				{
+					Type: "cosmos", ChainId: "localcosmos-1", Denom: "uatom", Actions: handlers.ChainActions("cosmos"),
+					Nodes: []*v1.Node{{Index: 0, Name: "val-0", Height: 10}, {Index: 1, Name: "val-1", Height: 11}},
+				},
+				{
+					Type: "ethereum", ChainId: "31337", Denom: "wei", Actions: handlers.ChainActions("ethereum"),
+					Nodes: []*v1.Node{{Index: 0, Name: "anvil", Height: 5}},
+				},
+			},
+			Paths:          []*v1.Path{{Name: "ibc-path", ChainIds: []string{"localcosmos-1", "localjuno-1"}}},
+			RelayerType:    "rly",
+			RelayerRunning: true,
+		},
+		Channels: []*v1.Channel{{ChannelId: "channel-0", PortId: "transfer", State: "STATE_OPEN"}},
+	}
+}
+
+// newTestModel returns a refreshed model of svc, running everything on the calling goroutine.
+func newTestModel(svc Service) *Model {
+	m := NewModel(context.Background(), svc, "http://127.0.0.1:8080")
+	m.spawn = func(f func()) { f() }
+	m.Refresh()
+	draw(m.RootView())
+	return m
+}
+
+func TestModel_Refresh(t *testing.T) {
+	svc := newMockService()
+	model := newTestModel(svc)
+
+	require.Equal(t, 3, model.views.chains.GetRowCount())
+	require.Equal(t, "localcosmos-1", model.chainID)
+	require.Equal(t, "11", model.views.chains.GetCell(1, 2).Text)
+	require.Equal(t, "Nodes", model.views.nodes.GetTitle())
+	require.Equal(t, 3, model.views.nodes.GetRowCount())
+	require.Equal(t, "channel-0", model.views.channels.GetCell(1, 0).Text)
+	require.Equal(t, []string{"localcosmos-1"}, svc.GotChannels)
+	require.Contains(t, model.status, "streams are not mocked")
+	require.Equal(t, "running", model.views.network.GetCell(3, 1).Text)
+
+	// Refreshing keeps the selected chain.
+	model.Refresh()
+	require.Equal(t, "localcosmos-1", model.chainID)
+	require.Len(t, svc.GotChannels, 1)
+
+	// Selecting the next chain shows it.
+	model.views.chains.InputHandler()(runeKey('j'), func(tview.Primitive) {})
+	require.Equal(t, "31337", model.chainID)
+	require.Equal(t, "Nodes", model.views.nodes.GetTitle())
+	require.Equal(t, "anvil", model.views.nodes.GetCell(1, 1).Text)
+}
+
+func TestModel_Update(t *testing.T) {
+	t.Run("go back", func(t *testing.T) {
+		model := newTestModel(newMockService())
+		require.Equal(t, 1, model.mainContentView().GetPageCount())
+
+		update := model.Update()
+		update(escKey)
+
+		require.Equal(t, 1, model.mainContentView().GetPageCount())
+	})
+
+	t.Run("faucet", func(t *testing.T) {
+		svc := newMockService()
+		model := newTestModel(svc)
+		update := model.Update()
+
+		require.Nil(t, update(runeKey('f')))
+		require.Equal(t, faucetMain, model.stack.Current())
+
+		_, view := model.mainContentView().GetFrontPage()
+		form := view.(*tview.Flex).GetItem(1).(*tview.Flex).GetItem(1).(*tview.Form)
+		form.GetFormItemByLabel("Address").(*tview.InputField).SetText("cosmos1abc")
+		form.GetButton(0).InputHandler()(enterKey, func(tview.Primitive) {})
+
+		require.Equal(t, dashboardMain, model.stack.Current())
+		require.Equal(t, &v1.FaucetRequest{ChainId: "localcosmos-1", Address: "cosmos1abc", Amount: defaultFaucetAmount}, svc.GotFaucet)
+		require.Equal(t, "faucet localcosmos-1: done", model.status)
+
+		// Cancel goes back without sending.
+		svc.GotFaucet = nil
+		update(runeKey('f'))
+		update(escKey)
+		require.Equal(t, dashboardMain, model.stack.Current())
+		require.Nil(t, svc.GotFaucet)
+	})
+
+	t.Run("faucet error", func(t *testing.T) {
+		svc := newMockService()
+		svc.Err = errors.New("boom")
+		model := newTestModel(svc)
+
+		model.faucet("localcosmos-1", "cosmos1abc", "1")
+
+		require.Equal(t, errorModalMain, model.stack.Current())
+		require.Equal(t, "faucet localcosmos-1: failed", model.status)
+	})
+
+	t.Run("restart relayer", func(t *testing.T) {
+		svc := newMockService()
+		model := newTestModel(svc)
+
+		require.Nil(t, model.Update()(runeKey('r')))
+
+		require.Equal(t, []string{"stop", "start", "ibc-path"}, svc.Calls)
+		require.Equal(t, "restart relayer: done", model.status)
+		require.Len(t, svc.GotChannels, 2)
+	})
+
+	t.Run("no relayer", func(t *testing.T) {
+		svc := newMockService()
+		svc.InfoRes.RelayerType = ""
+		model := newTestModel(svc)
+
+		model.Update()(runeKey('r'))
+
+		require.Empty(t, svc.Calls)
+		require.Empty(t, svc.GotChannels)
+		require.Equal(t, errorModalMain, model.stack.Current())
+		require.Equal(t, "none", model.views.network.GetCell(1, 1).Text)
+	})
+}
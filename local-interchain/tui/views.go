@@ -0,0 +1,239 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	v1 "github.com/strangelove-ventures/interchaintest/local-interchain/gen/localinterchain/v1"
+)
+
+// defaultFaucetAmount is the amount the faucet form starts with.
+const defaultFaucetAmount = "10000000"
+
+func headerView(m *Model) *tview.Flex {
+	flex := tview.NewFlex().SetDirection(tview.FlexColumn)
+	flex.SetBorder(false)
+	flex.SetBorderPadding(0, 0, 1, 1)
+
+	m.views.help = newHelpView().Replace(keyMap[dashboardMain])
+	flex.AddItem(m.views.help, 0, 2, false)
+
+	m.views.network = tview.NewTable().SetBorders(false)
+	m.views.network.SetBorder(false)
+	flex.AddItem(m.views.network, 0, 1, false)
+
+	return flex
+}
+
+// dashboardView is the initial main content: the chains of the network on the left,
+// and the nodes, channels, recent transactions and logs of the selected chain on the right.
+func dashboardView(m *Model) *tview.Flex {
+	m.views.chains = tableView("Chains").SetSelectable(true, false)
+	m.views.chains.SetSelectionChangedFunc(func(row, _ int) {
+		if id := m.views.chains.GetCell(row, 0).Text; row > 0 && id != "" {
+			m.selectChain(id)
+		}
+	})
+	m.views.nodes = tableView("Nodes")
+	m.views.channels = tableView("Channels")
+	m.views.txs = tableView("Recent Txs")
+
+	m.views.logs = tview.NewTextView().
+		SetMaxLines(maxLogLines).
+		SetScrollable(true).
+		SetWrap(false)
+	m.views.logs.
+		SetBorder(true).
+		SetBorderPadding(0, 0, 1, 1).
+		SetBorderAttributes(tcell.AttrDim).
+		SetTitle("Logs")
+
+	top := tview.NewFlex().SetDirection(tview.FlexColumn).
+		AddItem(m.views.nodes, 0, 1, false).
+		AddItem(m.views.channels, 0, 1, false)
+	chain := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(top, 0, 1, false).
+		AddItem(m.views.txs, 0, 1, false).
+		AddItem(m.views.logs, 0, 1, false)
+
+	return tview.NewFlex().SetDirection(tview.FlexColumn).
+		AddItem(m.views.chains, 0, 1, true).
+		AddItem(chain, 0, 2, false)
+}
+
+func tableView(title string) *tview.Table {
+	tbl := tview.NewTable().
+		SetBorders(false).
+		SetSelectedStyle(tcell.Style{}.Foreground(backgroundColor).Background(textColor)).
+		SetFixed(1, 0)
+	tbl.
+		SetBorder(true).
+		SetBorderPadding(0, 0, 1, 1).
+		SetBorderAttributes(tcell.AttrDim)
+
+	tbl.SetTitle(title)
+	return tbl
+}
+
+// fillTable replaces the content of tbl with the headers and rows.
+func fillTable(tbl *tview.Table, headers []string, rows [][]string) {
+	tbl.Clear()
+
+	headerCell := func(s string) *tview.TableCell {
+		return tview.NewTableCell(strings.ToUpper(s)).
+			SetStyle(textStyle.Bold(true)).
+			SetExpansion(1).
+			SetSelectable(false)
+	}
+	for col, header := range headers {
+		tbl.SetCell(0, col, headerCell(header))
+	}
+
+	contentCell := func(s string) *tview.TableCell {
+		return tview.NewTableCell(s).SetStyle(textStyle).SetExpansion(1)
+	}
+	for i, row := range rows {
+		for col, content := range row {
+			tbl.SetCell(i+1, col, contentCell(content)) // 1 offsets header row
+		}
+	}
+}
+
+// renderNetwork shows the API address, the relayer and the status line of the model.
+func (m *Model) renderNetwork() {
+	relayer, image, running, paths := "none", "", "", ""
+	if m.info != nil && m.info.RelayerType != "" {
+		relayer, image, running = m.info.RelayerType, m.info.RelayerImage, "stopped"
+		if m.info.RelayerRunning {
+			running = "running"
+		}
+		names := make([]string, len(m.info.Paths))
+		for i, p := range m.info.Paths {
+			names[i] = p.Name
+		}
+		paths = strings.Join(names, ", ")
+	}
+
+	tbl := m.views.network
+	tbl.Clear()
+	for row, kv := range [][2]string{
+		{"API:", m.apiAddr},
+		{"Relayer:", relayer},
+		{"Image:", image},
+		{"Relaying:", running},
+		{"Paths:", paths},
+		{"Status:", m.status},
+	} {
+		tbl.SetCell(row, 0, tview.NewTableCell(kv[0]).SetStyle(textStyle.Bold(true).Foreground(titleColor)))
+		tbl.SetCell(row, 1, tview.NewTableCell(kv[1]).SetStyle(textStyle))
+	}
+}
+
+// renderChains shows the chains of the network, keeping the selected chain selected.
+func (m *Model) renderChains() {
+	rows := make([][]string, len(m.info.Chains))
+	selected := 0
+	for i, c := range m.info.Chains {
+		var height int64
+		for _, n := range c.Nodes {
+			height = max(height, n.Height)
+		}
+		rows[i] = []string{c.ChainId, c.Type, strconv.FormatInt(height, 10), strconv.Itoa(len(c.Nodes))}
+		if c.ChainId == m.chainID {
+			selected = i
+		}
+	}
+
+	fillTable(m.views.chains, []string{"Chain ID", "Type", "Height", "Nodes"}, rows)
+	if len(rows) > 0 {
+		// Selecting the first chain initially also starts its streams.
+		m.views.chains.Select(selected+1, 0)
+	}
+}
+
+// renderChain shows the nodes, channels, and recent transactions of the selected chain.
+func (m *Model) renderChain() {
+	var rows [][]string
+	if c := m.chain(); c != nil {
+		for _, n := range c.Nodes {
+			rows = append(rows, []string{strconv.Itoa(int(n.Index)), n.Name, strconv.FormatInt(n.Height, 10)})
+		}
+	}
+	fillTable(m.views.nodes, []string{"Index", "Name", "Height"}, rows)
+
+	rows = nil
+	for _, c := range m.channels {
+		rows = append(rows, []string{
+			c.ChannelId,
+			c.PortId,
+			fmt.Sprintf("%s/%s", c.CounterpartyChannelId, c.CounterpartyPortId),
+			c.State,
+		})
+	}
+	fillTable(m.views.channels, []string{"Channel", "Port", "Counterparty", "State"}, rows)
+
+	rows = nil
+	for _, t := range m.txs {
+		rows = append(rows, []string{strconv.FormatInt(t.Height, 10), t.Time, t.Hash})
+	}
+	fillTable(m.views.txs, []string{"Height", "Time", "Hash"}, rows)
+}
+
+// chain returns the selected chain, or nil if none is selected.
+func (m *Model) chain() *v1.Chain {
+	if m.info == nil {
+		return nil
+	}
+	for _, c := range m.info.Chains {
+		if c.ChainId == m.chainID {
+			return c
+		}
+	}
+	return nil
+}
+
+// faucetFormView asks for the address and amount to send from the faucet of the chain.
+// send is called with the values of the form when the Send button is pressed.
+func faucetFormView(chainID, denom string, send func(address, amount string), cancel func()) *tview.Flex {
+	form := tview.NewForm().
+		AddInputField("Address", "", 64, nil, nil).
+		AddInputField("Amount", defaultFaucetAmount, 20, tview.InputFieldInteger, nil)
+	form.AddButton("Send", func() {
+		address := form.GetFormItemByLabel("Address").(*tview.InputField).GetText()
+		amount := form.GetFormItemByLabel("Amount").(*tview.InputField).GetText()
+		send(address, amount)
+	})
+	form.AddButton("Cancel", cancel)
+	form.
+		SetBorder(true).
+		SetBorderPadding(1, 1, 2, 2).
+		SetTitle(fmt.Sprintf("Faucet %s (%s)", chainID, denom))
+
+	// Flex centers the form. See: https://github.com/rivo/tview/wiki/Modal
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(form, 11, 1, true).
+			AddItem(nil, 0, 1, false), 90, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
+func errorModalView(err error) *tview.Flex {
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Error: %v", err)).
+		SetTextColor(errorTextColor).
+		SetBackgroundColor(backgroundColor)
+
+	// Flex centers the modal. See: https://github.com/rivo/tview/wiki/Modal
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(modal, 0, 1, true).
+			AddItem(nil, 0, 1, false), 0, 1, true).
+		AddItem(nil, 0, 1, false)
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import "fmt"
+
+// Check validates a single sample value, returning a descriptive error if it is not acceptable.
+type Check func(value float64) error
+
+// Equal checks that a value is exactly want, e.g. Equal(0) for a failure counter.
+func Equal(want float64) Check {
+	return func(value float64) error {
+		if value != want {
+			return fmt.Errorf("got %g, want %g", value, want)
+		}
+		return nil
+	}
+}
+
+// AtMost checks that a value does not exceed limit, e.g. to bound a mempool size.
+func AtMost(limit float64) Check {
+	return func(value float64) error {
+		if value > limit {
+			return fmt.Errorf("got %g, want at most %g", value, limit)
+		}
+		return nil
+	}
+}
+
+// AtLeast checks that a value is no smaller than limit, e.g. to assert packets were relayed.
+func AtLeast(limit float64) Check {
+	return func(value float64) error {
+		if value < limit {
+			return fmt.Errorf("got %g, want at least %g", value, limit)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,30 @@
+// Package metrics scrapes Prometheus metrics from chain nodes and relayers during a test,
+// and makes assertions about them.
+//
+// Metrics must be enabled before the containers start:
+// chains with ibc.ChainConfig.EnableTelemetry, and relayers with relayer.EnableMetrics.
+// Both cosmos.ChainNode and relayer.DockerRelayer then satisfy the Target interface.
+//
+// A single snapshot is taken with ScrapeTarget:
+//
+//	snap, err := metrics.ScrapeTarget(ctx, "rly", r)
+//	require.NoError(t, err)
+//	require.NoError(t, snap.Expect("cosmos_relayer_tx_failure", nil, metrics.Equal(0)))
+//
+// To observe metrics for the duration of a test, use a Recorder.
+// Passing a *testreporter.RelayerExecReporter to NewRecorder
+// additionally includes every snapshot in the test report:
+//
+//	rec := metrics.NewRecorder(eRep)
+//	rec.AddTarget("gaia-val-0", gaia.Validators[0])
+//	rec.AddTarget("rly", r)
+//
+//	recCtx, stop := context.WithCancel(ctx)
+//	errCh := make(chan error, 1)
+//	go func() { errCh <- rec.Run(recCtx, 5*time.Second) }()
+//	// ... test body ...
+//	stop()
+//	require.NoError(t, <-errCh)
+//
+//	require.NoError(t, rec.Expect("gaia-val-0", "cometbft_mempool_size", nil, metrics.AtMost(100)))
+package metrics
@@ -0,0 +1,168 @@
+package metrics_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/interchaintest/v8/metrics"
+)
+
+const sampleExposition = `# HELP cosmos_relayer_tx_failure The total number of failed transactions.
+# TYPE cosmos_relayer_tx_failure counter
+cosmos_relayer_tx_failure{chain="gaia-1",cause="out of gas"} 2
+cosmos_relayer_tx_failure{chain="osmosis-1",cause="out of gas"} 0
+# HELP cometbft_mempool_size Size of the mempool.
+# TYPE cometbft_mempool_size gauge
+cometbft_mempool_size{chain_id="gaia-1"} 7
+# HELP cometbft_consensus_block_interval_seconds Time between this and the last block.
+# TYPE cometbft_consensus_block_interval_seconds histogram
+cometbft_consensus_block_interval_seconds_bucket{chain_id="gaia-1",le="1"} 3
+cometbft_consensus_block_interval_seconds_bucket{chain_id="gaia-1",le="+Inf"} 5
+cometbft_consensus_block_interval_seconds_sum{chain_id="gaia-1"} 6.5
+cometbft_consensus_block_interval_seconds_count{chain_id="gaia-1"} 5
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	samples, err := metrics.Parse(strings.NewReader(sampleExposition))
+	require.NoError(t, err)
+
+	snap := &metrics.Snapshot{Source: "test", Samples: samples}
+
+	failures := snap.Get("cosmos_relayer_tx_failure", nil)
+	require.Len(t, failures, 2)
+
+	sum, ok := snap.Sum("cosmos_relayer_tx_failure", nil)
+	require.True(t, ok)
+	require.Equal(t, 2.0, sum)
+
+	sum, ok = snap.Sum("cosmos_relayer_tx_failure", map[string]string{"chain": "osmosis-1"})
+	require.True(t, ok)
+	require.Zero(t, sum)
+
+	_, ok = snap.Sum("does_not_exist", nil)
+	require.False(t, ok)
+
+	count, ok := snap.Sum("cometbft_consensus_block_interval_seconds_count", nil)
+	require.True(t, ok)
+	require.Equal(t, 5.0, count)
+
+	buckets := snap.Get("cometbft_consensus_block_interval_seconds_bucket", map[string]string{"le": "1"})
+	require.Len(t, buckets, 1)
+	require.Equal(t, 3.0, buckets[0].Value)
+
+	m := snap.Map()
+	require.Equal(t, 7.0, m[`cometbft_mempool_size{chain_id="gaia-1"}`])
+	require.Equal(t, 2.0, m[`cosmos_relayer_tx_failure{cause="out of gas",chain="gaia-1"}`])
+
+	_, err = metrics.Parse(strings.NewReader("not a metric line{"))
+	require.Error(t, err)
+}
+
+func TestSnapshot_Expect(t *testing.T) {
+	t.Parallel()
+
+	samples, err := metrics.Parse(strings.NewReader(sampleExposition))
+	require.NoError(t, err)
+	snap := &metrics.Snapshot{Source: "rly", Samples: samples}
+
+	require.NoError(t, snap.Expect("cosmos_relayer_tx_failure", map[string]string{"chain": "osmosis-1"}, metrics.Equal(0)))
+	require.NoError(t, snap.Expect("cometbft_mempool_size", nil, metrics.AtMost(10)))
+	require.NoError(t, snap.Expect("cometbft_mempool_size", nil, metrics.AtLeast(7)))
+
+	err = snap.Expect("cosmos_relayer_tx_failure", nil, metrics.Equal(0))
+	require.ErrorContains(t, err, `cosmos_relayer_tx_failure{cause="out of gas",chain="gaia-1"}`)
+	require.ErrorContains(t, err, "got 2, want 0")
+
+	require.Error(t, snap.Expect("cometbft_mempool_size", nil, metrics.AtMost(5)))
+	require.Error(t, snap.Expect("does_not_exist", nil, metrics.Equal(0)))
+}
+
+type staticTarget string
+
+func (t staticTarget) MetricsAddress(context.Context) (string, error) {
+	return string(t), nil
+}
+
+type fakeReporter struct {
+	mu      sync.Mutex
+	sources []string
+}
+
+func (r *fakeReporter) TrackMetrics(source string, _ time.Time, samples map[string]float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sources = append(r.sources, source)
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	mempool := 3
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "# TYPE cometbft_mempool_size gauge\ncometbft_mempool_size{chain_id=\"gaia-1\"} %d\n", mempool)
+		mempool += 10
+	}))
+	defer srv.Close()
+
+	rep := new(fakeReporter)
+	rec := metrics.NewRecorder(rep)
+	rec.AddTarget("gaia-val-0", staticTarget(srv.URL))
+
+	ctx := context.Background()
+	require.NoError(t, rec.Scrape(ctx))
+	require.NoError(t, rec.Scrape(ctx))
+
+	require.Len(t, rec.Snapshots("gaia-val-0"), 2)
+	latest := rec.Latest("gaia-val-0")
+	require.NotNil(t, latest)
+	sum, _ := latest.Sum("cometbft_mempool_size", nil)
+	require.Equal(t, 13.0, sum)
+
+	require.NoError(t, rec.Expect("gaia-val-0", "cometbft_mempool_size", nil, metrics.AtMost(20)))
+	require.Error(t, rec.Expect("gaia-val-0", "cometbft_mempool_size", nil, metrics.AtMost(10)))
+	require.Error(t, rec.Expect("gaia-val-0", "does_not_exist", nil, metrics.Equal(0)))
+	require.Nil(t, rec.Latest("unknown"))
+
+	require.Equal(t, []string{"gaia-val-0", "gaia-val-0"}, rep.sources)
+
+	rec.AddTarget("down", staticTarget("http://127.0.0.1:1/metrics"))
+	require.Error(t, rec.Scrape(ctx))
+	require.Len(t, rec.Snapshots("gaia-val-0"), 3)
+}
+
+func TestRecorder_Run(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# TYPE cosmos_relayer_tx_failure counter\ncosmos_relayer_tx_failure 0\n")
+	}))
+	defer srv.Close()
+
+	rec := metrics.NewRecorder(nil)
+	rec.AddTarget("rly", staticTarget(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- rec.Run(ctx, 10*time.Millisecond) }()
+
+	require.Eventually(t, func() bool {
+		return len(rec.Snapshots("rly")) >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	require.NoError(t, rec.Expect("rly", "cosmos_relayer_tx_failure", nil, metrics.Equal(0)))
+}
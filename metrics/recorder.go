@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+)
+
+// Reporter receives every snapshot taken by a Recorder.
+// The testreporter.RelayerExecReporter type satisfies this interface,
+// which includes the snapshots in the test report.
+type Reporter interface {
+	TrackMetrics(source string, when time.Time, samples map[string]float64)
+}
+
+// Recorder scrapes a set of targets, keeping every snapshot in memory
+// so that assertions can be made over the whole duration of a test.
+type Recorder struct {
+	rep Reporter
+
+	mu        sync.Mutex
+	sources   []string
+	targets   map[string]Target
+	snapshots map[string][]*Snapshot
+}
+
+// NewRecorder returns a Recorder with no targets.
+// If rep is non-nil, every snapshot is also passed to rep.
+func NewRecorder(rep Reporter) *Recorder {
+	return &Recorder{
+		rep:       rep,
+		targets:   make(map[string]Target),
+		snapshots: make(map[string][]*Snapshot),
+	}
+}
+
+// AddTarget adds a target to be scraped under the given source name.
+// Adding a target for an existing source name replaces the previous target.
+func (r *Recorder) AddTarget(source string, t Target) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.targets[source]; !ok {
+		r.sources = append(r.sources, source)
+	}
+	r.targets[source] = t
+}
+
+// Scrape takes one snapshot of every target.
+// Targets which fail to be scraped are skipped, and their errors are returned joined.
+func (r *Recorder) Scrape(ctx context.Context) error {
+	r.mu.Lock()
+	sources := append([]string(nil), r.sources...)
+	targets := make(map[string]Target, len(r.targets))
+	for k, v := range r.targets {
+		targets[k] = v
+	}
+	r.mu.Unlock()
+
+	var errs error
+	for _, source := range sources {
+		snap, err := ScrapeTarget(ctx, source, targets[source])
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		r.record(snap)
+	}
+	return errs
+}
+
+func (r *Recorder) record(snap *Snapshot) {
+	r.mu.Lock()
+	r.snapshots[snap.Source] = append(r.snapshots[snap.Source], snap)
+	r.mu.Unlock()
+
+	if r.rep != nil {
+		r.rep.TrackMetrics(snap.Source, snap.When, snap.Map())
+	}
+}
+
+// Run scrapes every target immediately and then once per interval, until ctx is done.
+// Scrape errors do not stop the recorder; they are returned joined once ctx is done.
+func (r *Recorder) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var errs error
+	for {
+		if err := r.Scrape(ctx); err != nil && ctx.Err() == nil {
+			errs = multierr.Append(errs, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errs
+		case <-ticker.C:
+		}
+	}
+}
+
+// Snapshots returns every snapshot of source, oldest first.
+func (r *Recorder) Snapshots(source string) []*Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Snapshot(nil), r.snapshots[source]...)
+}
+
+// Latest returns the most recent snapshot of source, or nil if there is none.
+func (r *Recorder) Latest(source string) *Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snaps := r.snapshots[source]
+	if len(snaps) == 0 {
+		return nil
+	}
+	return snaps[len(snaps)-1]
+}
+
+// Expect applies check to the matching samples of every snapshot of source,
+// which asserts a bound held throughout the test rather than only at the end.
+// Snapshots without a matching sample are ignored, because a metric
+// may only be reported after its first observation,
+// but an error is returned if no snapshot had a matching sample.
+func (r *Recorder) Expect(source, name string, labels map[string]string, check Check) error {
+	var found bool
+	for _, snap := range r.Snapshots(source) {
+		if len(snap.Get(name, labels)) == 0 {
+			continue
+		}
+		found = true
+		if err := snap.Expect(name, labels, check); err != nil {
+			return err
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s: no samples of %s matching labels %v in any snapshot", source, name, labels)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Target is a source of Prometheus metrics, such as a cosmos.ChainNode or relayer.DockerRelayer.
+type Target interface {
+	// MetricsAddress returns the host-accessible URL of the metrics endpoint.
+	MetricsAddress(ctx context.Context) (string, error)
+}
+
+// Sample is a single series value.
+//
+// Histograms and summaries are flattened the same way as in the text exposition format,
+// into "_bucket", "_sum", and "_count" samples, or quantile samples respectively.
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Series returns the sample's series identifier in the text exposition format,
+// e.g. `cometbft_mempool_size{chain_id="gaia-1"}`. Labels are sorted by name.
+func (s Sample) Series() string {
+	if len(s.Labels) == 0 {
+		return s.Name
+	}
+	names := make([]string, 0, len(s.Labels))
+	for k := range s.Labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(s.Name)
+	b.WriteByte('{')
+	for i, k := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(s.Labels[k]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// matches reports whether the sample has the given name and carries every label in labels.
+func (s Sample) matches(name string, labels map[string]string) bool {
+	if s.Name != name {
+		return false
+	}
+	for k, v := range labels {
+		if s.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Snapshot is the set of samples scraped from one source at one point in time.
+type Snapshot struct {
+	Source  string
+	When    time.Time
+	Samples []Sample
+}
+
+// Parse parses metrics in the Prometheus text exposition format.
+func Parse(r io.Reader) ([]Sample, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var samples []Sample
+	for _, name := range names {
+		samples = appendFamily(samples, families[name])
+	}
+	return samples, nil
+}
+
+func appendFamily(samples []Sample, mf *dto.MetricFamily) []Sample {
+	name := mf.GetName()
+	for _, m := range mf.GetMetric() {
+		labels := make(map[string]string, len(m.GetLabel()))
+		for _, lp := range m.GetLabel() {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+		with := func(k, v string) map[string]string {
+			l := make(map[string]string, len(labels)+1)
+			for lk, lv := range labels {
+				l[lk] = lv
+			}
+			l[k] = v
+			return l
+		}
+
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			samples = append(samples, Sample{Name: name, Labels: labels, Value: m.GetCounter().GetValue()})
+		case dto.MetricType_GAUGE:
+			samples = append(samples, Sample{Name: name, Labels: labels, Value: m.GetGauge().GetValue()})
+		case dto.MetricType_UNTYPED:
+			samples = append(samples, Sample{Name: name, Labels: labels, Value: m.GetUntyped().GetValue()})
+		case dto.MetricType_SUMMARY:
+			s := m.GetSummary()
+			for _, q := range s.GetQuantile() {
+				samples = append(samples, Sample{Name: name, Labels: with("quantile", formatFloat(q.GetQuantile())), Value: q.GetValue()})
+			}
+			samples = append(samples,
+				Sample{Name: name + "_sum", Labels: labels, Value: s.GetSampleSum()},
+				Sample{Name: name + "_count", Labels: labels, Value: float64(s.GetSampleCount())},
+			)
+		case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+			h := m.GetHistogram()
+			for _, b := range h.GetBucket() {
+				samples = append(samples, Sample{Name: name + "_bucket", Labels: with("le", formatFloat(b.GetUpperBound())), Value: float64(b.GetCumulativeCount())})
+			}
+			samples = append(samples,
+				Sample{Name: name + "_bucket", Labels: with("le", "+Inf"), Value: float64(h.GetSampleCount())},
+				Sample{Name: name + "_sum", Labels: labels, Value: h.GetSampleSum()},
+				Sample{Name: name + "_count", Labels: labels, Value: float64(h.GetSampleCount())},
+			)
+		}
+	}
+	return samples
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// Scrape fetches and parses the metrics served at url.
+func Scrape(ctx context.Context, source, url string) (*Snapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape metrics of %s: %w", source, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to scrape metrics of %s: unexpected status %s", source, res.Status)
+	}
+
+	when := time.Now()
+	samples, err := Parse(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	return &Snapshot{Source: source, When: when, Samples: samples}, nil
+}
+
+// ScrapeTarget fetches and parses the metrics of t.
+func ScrapeTarget(ctx context.Context, source string, t Target) (*Snapshot, error) {
+	url, err := t.MetricsAddress(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metrics address of %s: %w", source, err)
+	}
+	return Scrape(ctx, source, url)
+}
+
+// Get returns the samples named name that carry every label in labels.
+// A nil labels matches all samples of that name.
+func (s *Snapshot) Get(name string, labels map[string]string) []Sample {
+	var out []Sample
+	for _, sample := range s.Samples {
+		if sample.matches(name, labels) {
+			out = append(out, sample)
+		}
+	}
+	return out
+}
+
+// Sum returns the sum of the values returned by Get, and whether any sample matched.
+func (s *Snapshot) Sum(name string, labels map[string]string) (float64, bool) {
+	matched := s.Get(name, labels)
+	var sum float64
+	for _, sample := range matched {
+		sum += sample.Value
+	}
+	return sum, len(matched) > 0
+}
+
+// Expect applies check to every sample returned by Get.
+// An error is returned if no sample matched or if check fails for any sample.
+func (s *Snapshot) Expect(name string, labels map[string]string, check Check) error {
+	matched := s.Get(name, labels)
+	if len(matched) == 0 {
+		return fmt.Errorf("%s: no samples of %s matching labels %v", s.Source, name, labels)
+	}
+	for _, sample := range matched {
+		if err := check(sample.Value); err != nil {
+			return fmt.Errorf("%s: %s at %s: %w", s.Source, sample.Series(), s.When.Format(time.RFC3339), err)
+		}
+	}
+	return nil
+}
+
+// Map returns every sample value keyed by its Series.
+func (s *Snapshot) Map() map[string]float64 {
+	m := make(map[string]float64, len(s.Samples))
+	for _, sample := range s.Samples {
+		m[sample.Series()] = sample.Value
+	}
+	return m
+}
@@ -0,0 +1,326 @@
+package interchaintest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+)
+
+// ForwardReceiverPlaceholder is the receiver set on intermediate hops of a multi-hop transfer.
+// Packet forward middleware replaces it with an address derived from the sender,
+// so any non-empty value is accepted.
+const ForwardReceiverPlaceholder = "pfm"
+
+// MultiHopTransferOptions describes a transfer routed through intermediate chains by packet forward middleware.
+type MultiHopTransferOptions struct {
+	// Route of the transfer: the sending chain, every intermediate chain, and the receiving chain.
+	// Consecutive chains must be linked in the Topology. At least two chains are required.
+	Route []ibc.Chain
+
+	// Sender is a wallet on Route[0].
+	Sender ibc.Wallet
+
+	// Receiver is an address on the last chain of Route.
+	Receiver string
+
+	// Denom to send, as its full denom path on Route[0], e.g. "uatom" or "transfer/channel-0/uosmo".
+	// Defaults to the native denom of Route[0].
+	Denom string
+
+	Amount math.Int
+
+	// Port of the channels along the route. Defaults to "transfer".
+	Port string
+
+	// Timeout of each forwarded packet, and number of retries on timeout.
+	// Zero values use the packet forward middleware defaults.
+	ForwardTimeout time.Duration
+	ForwardRetries *uint8
+
+	// Number of blocks to wait for each acknowledgement. Defaults to 20 per hop.
+	AckBlocks int64
+}
+
+// MultiHopTransferResult describes the outcome of a multi-hop transfer.
+type MultiHopTransferResult struct {
+	// The transfer sent from the first chain of the route.
+	Tx ibc.Tx
+
+	// The forward memo attached to the transfer.
+	Memo string
+
+	// One entry per hop along the route.
+	Hops []TransferHop
+
+	// The denom the receiver is expected to hold on the last chain.
+	FinalDenom string
+
+	// True if any hop failed, in which case the sender was refunded.
+	Refunded bool
+}
+
+// TransferHop describes one hop of a multi-hop transfer.
+type TransferHop struct {
+	From, To ibc.Chain
+
+	// The channel carrying the hop, as seen from From.
+	Channel ibc.ChannelOutput
+
+	// The full denom path, and the denom as held in bank balances, of the tokens received on To.
+	DenomPath, Denom string
+
+	// The acknowledgement of the hop's packet on From.
+	// Unset for hops after a failed hop, as their packets may never have been sent.
+	Ack *ibc.PacketAcknowledgement
+
+	// The error returned in the acknowledgement, if the hop or any later hop failed.
+	AckError string
+}
+
+// SendMultiHopTransfer sends a transfer along opts.Route using packet forward middleware.
+//
+// It builds the nested forward memo from the channels in top, sends the transfer,
+// and waits for the acknowledgement of every hop with testutil.PollForAck.
+// It then validates the balances of the sender and receiver:
+// on success, the receiver holds opts.Amount more of the expected final ibc denom;
+// if any hop failed, the sender was refunded and the receiver's balance is unchanged.
+// The returned error describes any validation failure; a refunded transfer is not an error by itself,
+// so that refund paths can be tested by checking Refunded.
+func SendMultiHopTransfer(ctx context.Context, top *Topology, opts MultiHopTransferOptions) (*MultiHopTransferResult, error) {
+	if len(opts.Route) < 2 {
+		return nil, fmt.Errorf("multi-hop transfer route needs at least 2 chains, got %d", len(opts.Route))
+	}
+	if opts.Port == "" {
+		opts.Port = "transfer"
+	}
+	if opts.Denom == "" {
+		opts.Denom = opts.Route[0].Config().Denom
+	}
+	if opts.AckBlocks == 0 {
+		opts.AckBlocks = 20 * int64(len(opts.Route)-1)
+	}
+
+	hops, err := multiHopRoute(top, opts.Route, opts.Port, opts.Denom)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := PacketForwardMemo(hops[1:], opts.Receiver, opts.ForwardTimeout, opts.ForwardRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	src, dst := opts.Route[0], opts.Route[len(opts.Route)-1]
+	srcDenom := ibc.IBCDenom(opts.Denom)
+	finalDenom := hops[len(hops)-1].Denom
+
+	senderBefore, err := src.GetBalance(ctx, opts.Sender.FormattedAddress(), srcDenom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender balance: %w", err)
+	}
+	receiverBefore, err := dst.GetBalance(ctx, opts.Receiver, finalDenom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receiver balance: %w", err)
+	}
+
+	// Record the starting height of every chain, to bound the acknowledgement search.
+	startHeights := make([]int64, len(hops))
+	for i, hop := range hops {
+		if startHeights[i], err = hop.From.Height(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get height of %s: %w", hop.From.Config().ChainID, err)
+		}
+	}
+
+	firstReceiver := opts.Receiver
+	if len(hops) > 1 {
+		firstReceiver = ForwardReceiverPlaceholder
+	}
+	tx, err := src.SendIBCTransfer(ctx, hops[0].Channel.ChannelID, opts.Sender.KeyName(), ibc.WalletAmount{
+		Address: firstReceiver,
+		Denom:   srcDenom,
+		Amount:  opts.Amount,
+	}, ibc.TransferOptions{Memo: memo})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send multi-hop transfer: %w", err)
+	}
+
+	res := &MultiHopTransferResult{Tx: tx, Memo: memo, Hops: hops, FinalDenom: finalDenom}
+
+	// The first hop is only acknowledged once every later hop was,
+	// and carries the error of any failed hop.
+	ack, err := testutil.PollForAck(ctx, src, tx.Height, tx.Height+opts.AckBlocks, tx.Packet)
+	if err != nil {
+		return res, fmt.Errorf("failed to find acknowledgement of hop 1 on %s: %w", src.Config().ChainID, err)
+	}
+	res.Hops[0].Ack = &ack
+	res.Hops[0].AckError = ackError(ack.Acknowledgement)
+	res.Refunded = res.Hops[0].AckError != ""
+
+	if !res.Refunded {
+		for i := 1; i < len(res.Hops); i++ {
+			hop := &res.Hops[i]
+			receiver := opts.Receiver
+			if i < len(res.Hops)-1 {
+				receiver = ForwardReceiverPlaceholder
+			}
+			ack, err := pollForForwardAck(ctx, hop.From, startHeights[i], startHeights[i]+opts.AckBlocks, hop.Channel, res.Hops[i-1].DenomPath, opts.Amount, receiver)
+			if err != nil {
+				return res, fmt.Errorf("failed to find acknowledgement of hop %d on %s: %w", i+1, hop.From.Config().ChainID, err)
+			}
+			hop.Ack = &ack
+			hop.AckError = ackError(ack.Acknowledgement)
+		}
+	}
+
+	senderAfter, err := src.GetBalance(ctx, opts.Sender.FormattedAddress(), srcDenom)
+	if err != nil {
+		return res, fmt.Errorf("failed to get sender balance: %w", err)
+	}
+	receiverAfter, err := dst.GetBalance(ctx, opts.Receiver, finalDenom)
+	if err != nil {
+		return res, fmt.Errorf("failed to get receiver balance: %w", err)
+	}
+
+	received := receiverAfter.Sub(receiverBefore)
+	spent := senderBefore.Sub(senderAfter)
+	if res.Refunded {
+		if !received.IsZero() {
+			return res, fmt.Errorf("receiver balance of %s changed by %s after failed transfer: %s", finalDenom, received, res.Hops[0].AckError)
+		}
+		// Fees may be paid in the sent denom, but the amount itself must be returned.
+		if spent.GTE(opts.Amount) {
+			return res, fmt.Errorf("sender was not refunded %s%s after failed transfer: %s", opts.Amount, srcDenom, res.Hops[0].AckError)
+		}
+		return res, nil
+	}
+
+	if !received.Equal(opts.Amount) {
+		return res, fmt.Errorf("receiver balance of %s changed by %s, expected %s", finalDenom, received, opts.Amount)
+	}
+	if spent.LT(opts.Amount) {
+		return res, fmt.Errorf("sender balance of %s decreased by %s, expected at least %s", srcDenom, spent, opts.Amount)
+	}
+	return res, nil
+}
+
+// multiHopRoute resolves the channel of every hop along route,
+// and the denom path of the tokens after each hop, given the denom path on route[0].
+func multiHopRoute(top *Topology, route []ibc.Chain, port, denomPath string) ([]TransferHop, error) {
+	hops := make([]TransferHop, len(route)-1)
+	for i := range hops {
+		from, to := route[i], route[i+1]
+		ch, err := top.Channel(from, to, port)
+		if err != nil {
+			return nil, fmt.Errorf("hop %d of multi-hop transfer: %w", i+1, err)
+		}
+		denomPath = ibc.VoucherDenomPath(denomPath, ch)
+		hops[i] = TransferHop{
+			From:      from,
+			To:        to,
+			Channel:   ch,
+			DenomPath: denomPath,
+			Denom:     ibc.IBCDenom(denomPath),
+		}
+	}
+	return hops, nil
+}
+
+// forwardMetadata is the memo format of packet forward middleware.
+type forwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Retries  *uint8          `json:"retries,omitempty"`
+	Next     *forwardWrapper `json:"next,omitempty"`
+}
+
+type forwardWrapper struct {
+	Forward *forwardMetadata `json:"forward"`
+}
+
+// PacketForwardMemo returns the packet forward middleware memo that forwards a transfer
+// over each of the given hops in turn, with receiver as the recipient of the last hop.
+// The hops are those after the first, which is the transfer carrying the memo,
+// and each hop's Channel must be seen from the forwarding chain.
+// An empty memo is returned if hops is empty.
+func PacketForwardMemo(hops []TransferHop, receiver string, timeout time.Duration, retries *uint8) (string, error) {
+	var next *forwardWrapper
+	for i := len(hops) - 1; i >= 0; i-- {
+		r := ForwardReceiverPlaceholder
+		if i == len(hops)-1 {
+			r = receiver
+		}
+		md := &forwardMetadata{
+			Receiver: r,
+			Port:     hops[i].Channel.PortID,
+			Channel:  hops[i].Channel.ChannelID,
+			Retries:  retries,
+			Next:     next,
+		}
+		if timeout > 0 {
+			md.Timeout = timeout.String()
+		}
+		next = &forwardWrapper{Forward: md}
+	}
+	if next == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(next)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal forward memo: %w", err)
+	}
+	return string(b), nil
+}
+
+// pollForForwardAck finds the acknowledgement of a transfer forwarded by chain over ch,
+// which is identified by its denom path, amount, and receiver, as its sequence is not known in advance.
+func pollForForwardAck(ctx context.Context, chain ibc.Chain, startHeight, maxHeight int64, ch ibc.ChannelOutput, denomPath string, amount math.Int, receiver string) (ibc.PacketAcknowledgement, error) {
+	var zero ibc.PacketAcknowledgement
+	poll := func(ctx context.Context, height int64) (ibc.PacketAcknowledgement, error) {
+		acks, err := chain.Acknowledgements(ctx, height)
+		if err != nil {
+			return zero, err
+		}
+		for _, ack := range acks {
+			if ack.Packet.SourcePort != ch.PortID || ack.Packet.SourceChannel != ch.ChannelID {
+				continue
+			}
+			var data transfertypes.FungibleTokenPacketData
+			if err := json.Unmarshal(ack.Packet.Data, &data); err != nil {
+				continue
+			}
+			if data.Denom == denomPath && data.Amount == amount.String() && data.Receiver == receiver {
+				return ack, nil
+			}
+		}
+		return zero, testutil.ErrNotFound
+	}
+
+	poller := testutil.BlockPoller[ibc.PacketAcknowledgement]{CurrentHeight: chain.Height, PollFunc: poll}
+	return poller.DoPoll(ctx, startHeight, maxHeight)
+}
+
+// ackError returns the error of an ICS-20 acknowledgement, or "" if it is a success.
+func ackError(ack []byte) string {
+	var a struct {
+		Result []byte `json:"result"`
+		Error  string `json:"error"`
+	}
+	if err := json.Unmarshal(ack, &a); err != nil {
+		return fmt.Sprintf("undecodable acknowledgement %q: %v", ack, err)
+	}
+	if a.Error != "" {
+		return a.Error
+	}
+	if len(a.Result) == 0 {
+		return "acknowledgement has neither result nor error"
+	}
+	return ""
+}
@@ -0,0 +1,41 @@
+package interchaintest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketForwardMemo(t *testing.T) {
+	hops := []TransferHop{
+		{Channel: ibc.ChannelOutput{PortID: "transfer", ChannelID: "channel-1"}},
+		{Channel: ibc.ChannelOutput{PortID: "transfer", ChannelID: "channel-7"}},
+	}
+
+	memo, err := PacketForwardMemo(hops, "cosmos1receiver", 0, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"forward":{
+		"receiver":"pfm","port":"transfer","channel":"channel-1",
+		"next":{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-7"}}
+	}}`, memo)
+
+	retries := uint8(2)
+	memo, err = PacketForwardMemo(hops[1:], "cosmos1receiver", 10*time.Minute, &retries)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"forward":{
+		"receiver":"cosmos1receiver","port":"transfer","channel":"channel-7","timeout":"10m0s","retries":2
+	}}`, memo)
+
+	memo, err = PacketForwardMemo(nil, "cosmos1receiver", 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, memo)
+}
+
+func TestAckError(t *testing.T) {
+	require.Empty(t, ackError([]byte(`{"result":"AQ=="}`)))
+	require.Equal(t, "ABCI code: 6: error handling packet", ackError([]byte(`{"error":"ABCI code: 6: error handling packet"}`)))
+	require.NotEmpty(t, ackError([]byte(`{}`)))
+	require.NotEmpty(t, ackError([]byte(`not json`)))
+}
@@ -17,6 +17,32 @@ const (
 
 	// Whether the relayer supports a one-off flush command.
 	Flush
+
+	// Whether the relayer can register counterparty payees for ICS-29 fee-enabled channels.
+	FeeMiddleware
+
+	// Whether the relayer can drive the channel upgrade handshake (try, ack, confirm and open).
+	ChannelUpgrade
+
+	// Whether the relayer answers interchain queries requested through chain events,
+	// as used by Stride-style ICQ modules. Async-ICQ only needs regular packet relaying.
+	InterchainQueries
+
+	// Whether the relayer relays packets on ordered channels, such as interchain accounts channels.
+	OrderedChannels
+
+	// Whether the relayer completes the close handshake of a channel closed on one end,
+	// e.g. by a packet timing out on an ordered channel.
+	ChannelClose
+
+	// Whether the relayer can update the clients of a path on demand, e.g. after a long idle period.
+	ClientUpdate
+
+	// Whether the relayer relays many packets sent concurrently in both directions.
+	ConcurrentPackets
+
+	// Whether the relayer relays pending packets after being restarted in the middle of relaying them.
+	RestartRecovery
 )
 
 // FullCapabilities returns a mapping of all known relayer features to true,
@@ -29,5 +55,16 @@ func FullCapabilities() map[Capability]bool {
 		HeightTimeout:    true,
 
 		Flush: true,
+
+		FeeMiddleware:  true,
+		ChannelUpgrade: true,
+
+		InterchainQueries: true,
+
+		OrderedChannels:   true,
+		ChannelClose:      true,
+		ClientUpdate:      true,
+		ConcurrentPackets: true,
+		RestartRecovery:   true,
 	}
 }
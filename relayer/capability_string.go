@@ -11,11 +11,19 @@ func _() {
 	_ = x[TimestampTimeout-0]
 	_ = x[HeightTimeout-1]
 	_ = x[Flush-2]
+	_ = x[FeeMiddleware-3]
+	_ = x[ChannelUpgrade-4]
+	_ = x[InterchainQueries-5]
+	_ = x[OrderedChannels-6]
+	_ = x[ChannelClose-7]
+	_ = x[ClientUpdate-8]
+	_ = x[ConcurrentPackets-9]
+	_ = x[RestartRecovery-10]
 }
 
-const _Capability_name = "TimestampTimeoutHeightTimeoutFlush"
+const _Capability_name = "TimestampTimeoutHeightTimeoutFlushFeeMiddlewareChannelUpgradeInterchainQueriesOrderedChannelsChannelCloseClientUpdateConcurrentPacketsRestartRecovery"
 
-var _Capability_index = [...]uint8{0, 16, 29, 34}
+var _Capability_index = [...]uint8{0, 16, 29, 34, 47, 61, 78, 93, 105, 117, 134, 149}
 
 func (i Capability) String() string {
 	if i < 0 || i >= Capability(len(_Capability_index)-1) {
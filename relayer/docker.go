@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/docker/api/types"
 	volumetypes "github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
 	"go.uber.org/zap"
 
 	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
@@ -41,6 +44,8 @@ type DockerRelayer struct {
 	customImage *ibc.DockerImage
 	pullImage   bool
 
+	// mu guards containerLifecycle, as the relayer may be inspected while it is started or stopped.
+	mu sync.Mutex
 	// The ID of the container created by StartRelayer.
 	containerLifecycle *dockerutil.ContainerLifecycle
 
@@ -50,6 +55,8 @@ type DockerRelayer struct {
 	homeDir string
 
 	extraStartupFlags []string
+
+	metricsEnabled bool
 }
 
 var _ ibc.Relayer = (*DockerRelayer)(nil)
@@ -83,6 +90,11 @@ func NewDockerRelayer(ctx context.Context, log *zap.Logger, testName string, cli
 		return nil, fmt.Errorf("pulling container image %s: %w", containerImage.Ref(), err)
 	}
 
+	// An existing home volume is already owned by the relayer user and initialized.
+	if r.volumeName != "" {
+		return &r, nil
+	}
+
 	v, err := cli.VolumeCreate(ctx, volumetypes.CreateOptions{
 		// Have to leave Driver unspecified for Docker Desktop compatibility.
 
@@ -211,6 +223,11 @@ func (r *DockerRelayer) GetExtraStartupFlags() []string {
 	return r.extraStartupFlags
 }
 
+// MetricsEnabled reports whether the relayer was configured with EnableMetrics.
+func (r *DockerRelayer) MetricsEnabled() bool {
+	return r.metricsEnabled
+}
+
 func (r *DockerRelayer) GetWallet(chainID string) (ibc.Wallet, bool) {
 	wallet, ok := r.wallets[chainID]
 	return wallet, ok
@@ -222,6 +239,24 @@ func (r *DockerRelayer) CreateChannel(ctx context.Context, rep ibc.RelayerExecRe
 	return res.Err
 }
 
+// RegisterCounterpartyPayee registers the counterparty payee of relayerAddr for an ICS-29 fee-enabled channel.
+// Relayers whose commander does not implement PayeeCommander do not support fee middleware.
+func (r *DockerRelayer) RegisterCounterpartyPayee(ctx context.Context, rep ibc.RelayerExecReporter, chainID, channelID, portID, relayerAddr, counterpartyPayee string) error {
+	pc, ok := r.c.(PayeeCommander)
+	if !ok {
+		return fmt.Errorf("%s does not support registering counterparty payees", r.c.Name())
+	}
+	cmd := pc.RegisterCounterpartyPayee(chainID, channelID, portID, relayerAddr, counterpartyPayee, r.HomeDir())
+	res := r.Exec(ctx, rep, cmd, nil)
+	return res.Err
+}
+
+// UpgradeChannel is not supported by relayers driven solely through a RelayerCommander;
+// implementations supporting channel upgrades override it.
+func (r *DockerRelayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, step ibc.ChannelUpgradeStep, opts ibc.ChannelUpgradeOptions) error {
+	return fmt.Errorf("%s does not support channel upgrades", r.c.Name())
+}
+
 func (r *DockerRelayer) CreateClients(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, opts ibc.CreateClientOptions) error {
 	cmd := r.c.CreateClients(pathName, opts, r.HomeDir())
 	res := r.Exec(ctx, rep, cmd, nil)
@@ -356,39 +391,122 @@ func (r *DockerRelayer) UpdateClients(ctx context.Context, rep ibc.RelayerExecRe
 }
 
 func (r *DockerRelayer) StartRelayer(ctx context.Context, rep ibc.RelayerExecReporter, pathNames ...string) error {
-	if r.containerLifecycle != nil {
-		return fmt.Errorf("tried to start relayer again without stopping first")
-	}
-
 	containerImage := r.ContainerImage()
 	joinedPaths := strings.Join(pathNames, ".")
 	containerName := fmt.Sprintf("%s-%s-%s", r.c.Name(), joinedPaths, dockerutil.RandLowerCaseLetterString(5))
 
 	cmd := r.c.StartRelayer(r.HomeDir(), pathNames...)
 
-	r.containerLifecycle = dockerutil.NewContainerLifecycle(r.log, r.client, containerName)
+	cl := dockerutil.NewContainerLifecycle(r.log, r.client, containerName)
+	if evRep, ok := rep.(dockerutil.ContainerEventReporter); ok {
+		cl.SetEventReporter(evRep)
+	}
+
+	r.mu.Lock()
+	if r.containerLifecycle != nil {
+		r.mu.Unlock()
+		return fmt.Errorf("tried to start relayer again without stopping first")
+	}
+	r.containerLifecycle = cl
+	r.mu.Unlock()
+
+	var ports nat.PortMap
+	if mc, ok := r.c.(MetricsCommander); ok && r.metricsEnabled {
+		ports = nat.PortMap{nat.Port(mc.MetricsPort()): {}}
+	}
 
-	if err := r.containerLifecycle.CreateContainer(
-		ctx, r.testName, r.networkID, containerImage, nil,
+	if err := cl.CreateContainer(
+		ctx, r.testName, r.networkID, containerImage, ports,
 		r.Bind(), nil, r.HostName(joinedPaths), cmd, nil,
 	); err != nil {
 		return err
 	}
 
-	return r.containerLifecycle.StartContainer(ctx)
+	return cl.StartContainer(ctx)
+}
+
+// MetricsAddress returns the host-accessible URL of the Prometheus metrics endpoint
+// of the relayer started by StartRelayer, e.g. "http://127.0.0.1:49153/metrics".
+// The relayer must have been created with EnableMetrics.
+func (r *DockerRelayer) MetricsAddress(ctx context.Context) (string, error) {
+	mc, ok := r.c.(MetricsCommander)
+	if !ok {
+		return "", fmt.Errorf("relayer %s does not support metrics", r.c.Name())
+	}
+	if !r.metricsEnabled {
+		return "", fmt.Errorf("metrics are not enabled for relayer %s", r.c.Name())
+	}
+	cl := r.lifecycle()
+	if cl == nil {
+		return "", fmt.Errorf("relayer is not running")
+	}
+	hostPorts, err := cl.GetHostPorts(ctx, mc.MetricsPort())
+	if err != nil {
+		return "", err
+	}
+	if hostPorts[0] == "" {
+		return "", fmt.Errorf("metrics port %s of relayer %s is not bound", mc.MetricsPort(), r.c.Name())
+	}
+	return "http://" + hostPorts[0] + mc.MetricsPath(), nil
+}
+
+// StreamLogs follows the logs of the relayer started by StartRelayer,
+// starting with lines written at the time of the call.
+// The returned channel is closed once ctx is done or the relayer stops.
+func (r *DockerRelayer) StreamLogs(ctx context.Context) (<-chan dockerutil.LogLine, error) {
+	cl := r.lifecycle()
+	if cl == nil {
+		return nil, fmt.Errorf("relayer is not running")
+	}
+	return cl.StreamLogs(ctx, dockerutil.LogStreamOptions{
+		Since:  time.Now(),
+		Follow: true,
+	})
+}
+
+// WaitForLog blocks until the running relayer logs a line matching re, starting from the time of the call,
+// and returns that line. This is useful to wait for events such as a client update.
+func (r *DockerRelayer) WaitForLog(ctx context.Context, re *regexp.Regexp) (dockerutil.LogLine, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logs, err := r.StreamLogs(ctx)
+	if err != nil {
+		return dockerutil.LogLine{}, err
+	}
+	return dockerutil.WaitForLog(ctx, logs, re)
+}
+
+// AssertNoLog checks the running relayer's entire log history up to now
+// and returns an error for the first line matching re.
+// Use dockerutil.FatalLogPattern to detect panics.
+func (r *DockerRelayer) AssertNoLog(ctx context.Context, re *regexp.Regexp) error {
+	cl := r.lifecycle()
+	if cl == nil {
+		return fmt.Errorf("relayer is not running")
+	}
+	logs, err := cl.StreamLogs(ctx, dockerutil.LogStreamOptions{})
+	if err != nil {
+		return err
+	}
+	if err := dockerutil.AssertNoLog(ctx, logs, re); err != nil {
+		return fmt.Errorf("relayer %s: %w", r.c.Name(), err)
+	}
+	return ctx.Err()
 }
 
 func (r *DockerRelayer) StopRelayer(ctx context.Context, rep ibc.RelayerExecReporter) error {
-	if r.containerLifecycle == nil {
+	cl := r.lifecycle()
+	if cl == nil {
 		return nil
 	}
-	if err := r.containerLifecycle.StopContainer(ctx); err != nil {
+	if err := cl.StopContainer(ctx); err != nil {
 		return err
 	}
 
 	stdoutBuf := new(bytes.Buffer)
 	stderrBuf := new(bytes.Buffer)
-	containerID := r.containerLifecycle.ContainerID()
+	containerID := cl.ContainerID()
 	rc, err := r.client.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
 		ShowStdout: true,
 		ShowStderr: true,
@@ -442,27 +560,36 @@ func (r *DockerRelayer) StopRelayer(ctx context.Context, rep ibc.RelayerExecRepo
 		zap.String("container", c.Name),
 	)
 
-	if err := r.containerLifecycle.RemoveContainer(ctx); err != nil {
+	// The container is removed before DockerCleanup collects the artifacts of the test.
+	if err := dockerutil.SaveContainerArtifacts(ctx, r.client, r.testName, containerID); err != nil {
+		r.log.Info("Failed to save relayer artifacts", zap.String("container", c.Name), zap.Error(err))
+	}
+
+	if err := cl.RemoveContainer(ctx); err != nil {
 		return err
 	}
 
+	r.mu.Lock()
 	r.containerLifecycle = nil
+	r.mu.Unlock()
 
 	return nil
 }
 
 func (r *DockerRelayer) PauseRelayer(ctx context.Context) error {
-	if r.containerLifecycle == nil {
+	cl := r.lifecycle()
+	if cl == nil {
 		return fmt.Errorf("container not running")
 	}
-	return r.client.ContainerPause(ctx, r.containerLifecycle.ContainerID())
+	return r.client.ContainerPause(ctx, cl.ContainerID())
 }
 
 func (r *DockerRelayer) ResumeRelayer(ctx context.Context) error {
-	if r.containerLifecycle == nil {
+	cl := r.lifecycle()
+	if cl == nil {
 		return fmt.Errorf("container not running")
 	}
-	return r.client.ContainerUnpause(ctx, r.containerLifecycle.ContainerID())
+	return r.client.ContainerUnpause(ctx, cl.ContainerID())
 }
 
 func (r *DockerRelayer) ContainerImage() ibc.DockerImage {
@@ -500,6 +627,24 @@ func (r *DockerRelayer) Bind() []string {
 	return []string{r.volumeName + ":" + r.HomeDir()}
 }
 
+// Running reports whether the container of the relayer started by StartRelayer is running.
+func (r *DockerRelayer) Running(ctx context.Context) bool {
+	cl := r.lifecycle()
+	return cl != nil && cl.Running(ctx) == nil
+}
+
+// lifecycle returns the container started by StartRelayer, or nil if the relayer was stopped since.
+func (r *DockerRelayer) lifecycle() *dockerutil.ContainerLifecycle {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.containerLifecycle
+}
+
+// VolumeName returns the name of the docker volume holding the relayer's home directory.
+func (r *DockerRelayer) VolumeName() string {
+	return r.volumeName
+}
+
 // HomeDir returns the home directory of the relayer on the underlying Docker container's filesystem.
 func (r *DockerRelayer) HomeDir() string {
 	return r.homeDir
@@ -574,3 +719,19 @@ type RelayerCommander interface {
 	UpdateClients(pathName, homeDir string) []string
 	CreateWallet(keyName, address, mnemonic string) ibc.Wallet
 }
+
+// MetricsCommander is optionally implemented by a RelayerCommander
+// whose relayer can serve Prometheus metrics; see EnableMetrics.
+type MetricsCommander interface {
+	// MetricsPort is the container port serving metrics, e.g. "5184/tcp".
+	MetricsPort() string
+
+	// MetricsPath is the HTTP path of the metrics endpoint, e.g. "/metrics".
+	MetricsPath() string
+}
+
+// PayeeCommander is optionally implemented by a RelayerCommander
+// whose relayer can register counterparty payees on ICS-29 fee-enabled channels.
+type PayeeCommander interface {
+	RegisterCounterpartyPayee(chainID, channelID, portID, relayerAddr, counterpartyPayee, homeDir string) []string
+}
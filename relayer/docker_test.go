@@ -0,0 +1,34 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// noFeeCommander is a commander that does not implement PayeeCommander.
+type noFeeCommander struct{ RelayerCommander }
+
+func (noFeeCommander) Name() string { return "nofee" }
+
+func TestDockerRelayer_RegisterCounterpartyPayeeUnsupported(t *testing.T) {
+	r := &DockerRelayer{log: zap.NewNop(), c: noFeeCommander{}}
+
+	err := r.RegisterCounterpartyPayee(context.Background(), nil, "chain-a", "channel-0", "transfer", "cosmos1relayer", "cosmos1payee")
+	require.EqualError(t, err, "nofee does not support registering counterparty payees")
+}
+
+func TestDockerRelayer_AssertNoLogNotRunning(t *testing.T) {
+	r := &DockerRelayer{log: zap.NewNop(), c: noFeeCommander{}}
+
+	require.EqualError(t, r.AssertNoLog(context.Background(), dockerutil.FatalLogPattern), "relayer is not running")
+}
+
+func TestDockerRelayer_RunningNotStarted(t *testing.T) {
+	r := &DockerRelayer{log: zap.NewNop(), c: noFeeCommander{}}
+
+	require.False(t, r.Running(context.Background()))
+}
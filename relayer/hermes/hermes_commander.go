@@ -32,6 +32,14 @@ func (c commander) DefaultContainerVersion() string {
 	return DefaultContainerVersion
 }
 
+func (c commander) MetricsPort() string {
+	return fmt.Sprintf("%d/tcp", hermesTelemetryPort)
+}
+
+func (c commander) MetricsPath() string {
+	return "/metrics"
+}
+
 func (c commander) DockerUser() string {
 	return hermesDefaultUidGid
 }
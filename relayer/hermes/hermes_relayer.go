@@ -23,6 +23,9 @@ const (
 	hermesDefaultUidGid = "1000:1000"
 	hermesHome          = "/home/hermes"
 	hermesConfigPath    = ".hermes/config.toml"
+
+	// hermesTelemetryPort is the port on which hermes serves metrics when started with EnableMetrics.
+	hermesTelemetryPort = 3001
 )
 
 var (
@@ -59,6 +62,30 @@ type pathChainConfig struct {
 	portID       string
 }
 
+// Capabilities returns the set of capabilities of hermes.
+// Capabilities added to the relayer package are unsupported until they are listed here.
+func Capabilities() map[relayer.Capability]bool {
+	return map[relayer.Capability]bool{
+		relayer.TimestampTimeout: true,
+		relayer.HeightTimeout:    true,
+
+		// Flush clears the pending packets of a channel with `hermes clear packets`.
+		relayer.Flush: true,
+
+		relayer.FeeMiddleware:  true,
+		relayer.ChannelUpgrade: true,
+
+		// Hermes answers the cross-chain queries emitted by Stride-style ICQ modules.
+		relayer.InterchainQueries: true,
+
+		relayer.OrderedChannels:   true,
+		relayer.ChannelClose:      true,
+		relayer.ClientUpdate:      true,
+		relayer.ConcurrentPackets: true,
+		relayer.RestartRecovery:   true,
+	}
+}
+
 // NewHermesRelayer returns a new hermes relayer.
 func NewHermesRelayer(log *zap.Logger, testName string, cli *client.Client, networkID string, options ...relayer.RelayerOpt) *Relayer {
 	c := commander{log: log}
@@ -268,6 +295,50 @@ func (r *Relayer) Flush(ctx context.Context, rep ibc.RelayerExecReporter, pathNa
 	return res.Err
 }
 
+// RegisterCounterpartyPayee registers the counterparty payee for the hermes key configured for chainID.
+// Hermes always signs with that key, so relayerAddr must be its address.
+func (r *Relayer) RegisterCounterpartyPayee(ctx context.Context, rep ibc.RelayerExecReporter, chainID, channelID, portID, relayerAddr, counterpartyPayee string) error {
+	cmd := []string{hermes, "--json", "fee", "register-counterparty-payee", "--chain", chainID, "--channel", channelID, "--port", portID, "--counterparty-payee", counterpartyPayee}
+	res := r.Exec(ctx, rep, cmd, nil)
+	return res.Err
+}
+
+// UpgradeChannel submits the given step of the channel upgrade handshake to opts.ChainID,
+// relaying the counterparty's channel state from the other chain of the path.
+func (r *Relayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, step ibc.ChannelUpgradeStep, opts ibc.ChannelUpgradeOptions) error {
+	pathConfig, ok := r.paths[pathName]
+	if !ok {
+		return fmt.Errorf("path %s not found", pathName)
+	}
+
+	var src pathChainConfig
+	switch opts.ChainID {
+	case pathConfig.chainA.chainID:
+		src = pathConfig.chainB
+	case pathConfig.chainB.chainID:
+		src = pathConfig.chainA
+	default:
+		return fmt.Errorf("chain %s is not on path %s", opts.ChainID, pathName)
+	}
+
+	if len(opts.Channel.ConnectionHops) == 0 {
+		return fmt.Errorf("channel %s on %s has no connection hops", opts.Channel.ChannelID, opts.ChainID)
+	}
+
+	cmd := []string{
+		hermes, "--json", "tx", "chan-upgrade-" + step.String(),
+		"--dst-chain", opts.ChainID,
+		"--src-chain", src.chainID,
+		"--dst-connection", opts.Channel.ConnectionHops[0],
+		"--dst-port", opts.Channel.PortID,
+		"--src-port", opts.Channel.Counterparty.PortID,
+		"--dst-channel", opts.Channel.ChannelID,
+		"--src-channel", opts.Channel.Counterparty.ChannelID,
+	}
+	res := r.Exec(ctx, rep, cmd, nil)
+	return res.Err
+}
+
 // GeneratePath establishes an in memory path representation. The concept does not exist in hermes, so it is handled
 // at the interchain test level.
 func (r *Relayer) GeneratePath(ctx context.Context, rep ibc.RelayerExecReporter, srcChainID, dstChainID, pathName string) error {
@@ -296,6 +367,13 @@ func (r *Relayer) configContent(cfg ibc.ChainConfig, keyName, rpcAddr, grpcAddr
 		grpcAddr: grpcAddr,
 	})
 	hermesConfig := NewConfig(r.chainConfigs...)
+	if r.MetricsEnabled() {
+		hermesConfig.Telemetry = Telemetry{
+			Enabled: true,
+			Host:    "0.0.0.0",
+			Port:    hermesTelemetryPort,
+		}
+	}
 	bz, err := toml.Marshal(hermesConfig)
 	if err != nil {
 		return nil, err
@@ -303,9 +381,14 @@ func (r *Relayer) configContent(cfg ibc.ChainConfig, keyName, rpcAddr, grpcAddr
 	return bz, nil
 }
 
+// ConfigPath returns the path of the hermes config file inside the relayer container.
+func (r *Relayer) ConfigPath() string {
+	return fmt.Sprintf("%s/%s", r.HomeDir(), hermesConfigPath)
+}
+
 // validateConfig validates the hermes config file. Any errors are propagated to the test.
 func (r *Relayer) validateConfig(ctx context.Context, rep ibc.RelayerExecReporter) error {
-	cmd := []string{hermes, "--config", fmt.Sprintf("%s/%s", r.HomeDir(), hermesConfigPath), "config", "validate"}
+	cmd := []string{hermes, "--config", r.ConfigPath(), "config", "validate"}
 	res := r.Exec(ctx, rep, cmd, nil)
 	if res.Err != nil {
 		return res.Err
@@ -32,6 +32,14 @@ func HomeDir(homeDir string) RelayerOpt {
 	}
 }
 
+// HomeVolume makes the relayer use an existing docker volume as its home directory,
+// such as one kept from a previous run, instead of creating and initializing a new one.
+func HomeVolume(volumeName string) RelayerOpt {
+	return func(r *DockerRelayer) {
+		r.volumeName = volumeName
+	}
+}
+
 // ImagePull overrides whether the relayer image should be pulled on startup.
 func ImagePull(pull bool) RelayerOpt {
 	return func(r *DockerRelayer) {
@@ -45,3 +53,12 @@ func StartupFlags(flags ...string) RelayerOpt {
 		r.extraStartupFlags = flags
 	}
 }
+
+// EnableMetrics configures the relayer to serve Prometheus metrics while it is running,
+// and exposes the metrics port so it can be scraped from the host with DockerRelayer.MetricsAddress.
+// It has no effect for relayers that do not implement MetricsCommander.
+func EnableMetrics() RelayerOpt {
+	return func(r *DockerRelayer) {
+		r.metricsEnabled = true
+	}
+}
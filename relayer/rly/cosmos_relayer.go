@@ -16,6 +16,9 @@ import (
 
 const (
 	RlyDefaultUidGid = "100:1000"
+
+	// rlyMetricsPort is the port on which rly serves metrics when started with EnableMetrics.
+	rlyMetricsPort = "5184/tcp"
 )
 
 // CosmosRelayer is the ibc.Relayer implementation for github.com/cosmos/relayer.
@@ -33,6 +36,12 @@ func NewCosmosRelayer(log *zap.Logger, testName string, cli *client.Client, netw
 	}
 
 	c.extraStartFlags = dr.GetExtraStartupFlags()
+	if dr.MetricsEnabled() {
+		c.extraStartFlags = append(c.extraStartFlags,
+			"--enable-metrics-server",
+			"--metrics-listen-addr", "0.0.0.0:5184",
+		)
+	}
 
 	r := &CosmosRelayer{
 		DockerRelayer: dr,
@@ -71,8 +80,12 @@ const (
 // Note, this API may change if the rly package eventually needs
 // to distinguish between multiple rly versions.
 func Capabilities() map[relayer.Capability]bool {
-	// RC1 matches the full set of capabilities as of writing.
-	return relayer.FullCapabilities()
+	caps := relayer.FullCapabilities()
+
+	// The Go relayer does not implement the channel upgrade handshake.
+	caps[relayer.ChannelUpgrade] = false
+
+	return caps
 }
 
 func ChainConfigToCosmosRelayerChainConfig(chainConfig ibc.ChainConfig, keyName, rpcAddr, gprcAddr string) CosmosRelayerChainConfig {
@@ -193,6 +206,13 @@ func (commander) Flush(pathName, channelID, homeDir string) []string {
 	return cmd
 }
 
+func (commander) RegisterCounterpartyPayee(chainID, channelID, portID, relayerAddr, counterpartyPayee, homeDir string) []string {
+	return []string{
+		"rly", "tx", "register-counterparty", chainID, channelID, portID, relayerAddr, counterpartyPayee,
+		"--home", homeDir,
+	}
+}
+
 func (commander) GeneratePath(srcChainID, dstChainID, pathName, homeDir string) []string {
 	return []string{
 		"rly", "paths", "new", srcChainID, dstChainID, pathName,
@@ -291,6 +311,14 @@ func (c commander) StartRelayer(homeDir string, pathNames ...string) []string {
 	return cmd
 }
 
+func (commander) MetricsPort() string {
+	return rlyMetricsPort
+}
+
+func (commander) MetricsPath() string {
+	return "/relayer/metrics"
+}
+
 func (commander) UpdateClients(pathName, homeDir string) []string {
 	return []string{
 		"rly", "tx", "update-clients", pathName,
@@ -0,0 +1,106 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"go.uber.org/multierr"
+)
+
+// transferQuerier is implemented by chains which can report their ICS-20 escrow balances and voucher supply,
+// such as cosmos.CosmosChain.
+type transferQuerier interface {
+	TransferQueryEscrowAddress(ctx context.Context, portID, channelID string) (string, error)
+	TransferQueryDenomTrace(ctx context.Context, hash string) (*transfertypes.DenomTrace, error)
+	BankQueryAllBalances(ctx context.Context, address string) (sdk.Coins, error)
+	BankQueryTotalSupplyOf(ctx context.Context, denom string) (*sdk.Coin, error)
+}
+
+var _ transferQuerier = (*cosmos.CosmosChain)(nil)
+
+// AssertSupplyConservation checks every ICS-20 channel created by Build; see AssertSupplyConservation.
+func (ic *Interchain) AssertSupplyConservation(ctx context.Context) error {
+	return AssertSupplyConservation(ctx, ic.Topology())
+}
+
+// AssertSupplyConservation checks, for every ICS-20 channel in top and in both directions,
+// that each denom escrowed on the sending chain is matched exactly by the total supply
+// of the corresponding voucher on the receiving chain.
+//
+// The check only holds while no transfers are in flight, so relayers should be flushed first.
+// Channels on chains which cannot report escrow balances and supply, such as non-cosmos chains, are skipped.
+// The returned error describes every mismatch found.
+func AssertSupplyConservation(ctx context.Context, top *Topology) error {
+	var errs error
+	for _, l := range top.Links() {
+		for _, ch := range l.Channels {
+			if !strings.Contains(ch.Version, transfertypes.Version) {
+				continue
+			}
+			errs = multierr.Append(errs, assertEscrowMatchesSupply(ctx, l.Chain1.Chain, l.Chain2.Chain, ch))
+			errs = multierr.Append(errs, assertEscrowMatchesSupply(ctx, l.Chain2.Chain, l.Chain1.Chain, flipChannel(ch, l.Chain2.ConnectionID)))
+		}
+	}
+	return errs
+}
+
+// assertEscrowMatchesSupply compares the escrow of ch on src with the voucher supply on dst,
+// where ch is seen from src.
+func assertEscrowMatchesSupply(ctx context.Context, src, dst ibc.Chain, ch ibc.ChannelOutput) error {
+	srcQ, ok := src.(transferQuerier)
+	if !ok {
+		return nil
+	}
+	dstQ, ok := dst.(transferQuerier)
+	if !ok {
+		return nil
+	}
+	srcID, dstID := src.Config().ChainID, dst.Config().ChainID
+
+	escrow, err := srcQ.TransferQueryEscrowAddress(ctx, ch.PortID, ch.ChannelID)
+	if err != nil {
+		return fmt.Errorf("failed to query escrow address of %s/%s on %s: %w", ch.PortID, ch.ChannelID, srcID, err)
+	}
+	escrowed, err := srcQ.BankQueryAllBalances(ctx, escrow)
+	if err != nil {
+		return fmt.Errorf("failed to query escrow balance of %s/%s on %s: %w", ch.PortID, ch.ChannelID, srcID, err)
+	}
+
+	var errs error
+	for _, coin := range escrowed {
+		denomPath := coin.Denom
+		if strings.HasPrefix(coin.Denom, transfertypes.DenomPrefix+"/") {
+			trace, err := srcQ.TransferQueryDenomTrace(ctx, coin.Denom)
+			if err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("failed to query denom trace of %s on %s: %w", coin.Denom, srcID, err))
+				continue
+			}
+			denomPath = trace.GetFullDenomPath()
+		}
+
+		voucher := ibc.VoucherDenom(denomPath, ch)
+		supply, err := dstQ.BankQueryTotalSupplyOf(ctx, voucher)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to query supply of %s on %s: %w", voucher, dstID, err))
+			continue
+		}
+
+		supplied := sdkmath.ZeroInt()
+		if supply != nil {
+			supplied = supply.Amount
+		}
+		if !supplied.Equal(coin.Amount) {
+			errs = multierr.Append(errs, fmt.Errorf(
+				"%s escrowed on %s channel %s/%s, but supply of voucher %s on %s is %s",
+				coin, srcID, ch.PortID, ch.ChannelID, voucher, dstID, supplied,
+			))
+		}
+	}
+	return errs
+}
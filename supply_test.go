@@ -0,0 +1,97 @@
+package interchaintest
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+)
+
+// supplyChain is an ibc.Chain reporting fixed escrow balances and supply.
+type supplyChain struct {
+	ibc.Chain // Unused methods panic.
+
+	chainID string
+
+	// Escrow balances keyed by channel ID.
+	escrow map[string]sdk.Coins
+	supply map[string]sdkmath.Int
+	traces map[string]transfertypes.DenomTrace
+}
+
+func (c *supplyChain) Config() ibc.ChainConfig {
+	return ibc.ChainConfig{ChainID: c.chainID}
+}
+
+func (c *supplyChain) TransferQueryEscrowAddress(_ context.Context, _, channelID string) (string, error) {
+	return "escrow-" + channelID, nil
+}
+
+func (c *supplyChain) TransferQueryDenomTrace(_ context.Context, hash string) (*transfertypes.DenomTrace, error) {
+	trace := c.traces[hash]
+	return &trace, nil
+}
+
+func (c *supplyChain) BankQueryAllBalances(_ context.Context, address string) (sdk.Coins, error) {
+	return c.escrow[address[len("escrow-"):]], nil
+}
+
+func (c *supplyChain) BankQueryTotalSupplyOf(_ context.Context, denom string) (*sdk.Coin, error) {
+	amount, ok := c.supply[denom]
+	if !ok {
+		amount = sdkmath.ZeroInt()
+	}
+	coin := sdk.NewCoin(denom, amount)
+	return &coin, nil
+}
+
+func TestAssertSupplyConservation(t *testing.T) {
+	// gaia channel-0 <-> osmosis channel-3.
+	ch := ibc.ChannelOutput{
+		PortID: "transfer", ChannelID: "channel-0", Version: "ics20-1",
+		Counterparty: ibc.ChannelCounterparty{PortID: "transfer", ChannelID: "channel-3"},
+	}
+	atomOnOsmosis := ibc.VoucherDenom("uatom", ch)
+
+	// Osmosis sent back vouchers of a third chain's token, received by osmosis on channel-7.
+	junoTrace := transfertypes.ParseDenomTrace("transfer/channel-7/ujuno")
+	junoOnOsmosis := junoTrace.IBCDenom()
+	junoOnGaia := ibc.IBCDenom("transfer/channel-0/transfer/channel-7/ujuno")
+
+	gaia := &supplyChain{
+		chainID: "gaia-1",
+		escrow:  map[string]sdk.Coins{"channel-0": sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))},
+		supply:  map[string]sdkmath.Int{junoOnGaia: sdkmath.NewInt(5)},
+	}
+	osmosis := &supplyChain{
+		chainID: "osmosis-1",
+		escrow:  map[string]sdk.Coins{"channel-3": sdk.NewCoins(sdk.NewInt64Coin(junoOnOsmosis, 5))},
+		supply:  map[string]sdkmath.Int{atomOnOsmosis: sdkmath.NewInt(100)},
+		traces:  map[string]transfertypes.DenomTrace{junoOnOsmosis: junoTrace},
+	}
+
+	link := &Link{
+		Path:     "p",
+		Chain1:   LinkEnd{Chain: gaia, ConnectionID: "connection-0"},
+		Chain2:   LinkEnd{Chain: osmosis, ConnectionID: "connection-1"},
+		Channels: []ibc.ChannelOutput{ch},
+	}
+	top := NewTopology(link)
+	ctx := context.Background()
+
+	require.NoError(t, AssertSupplyConservation(ctx, top))
+
+	// Vouchers minted without a matching escrow are reported.
+	osmosis.supply[atomOnOsmosis] = sdkmath.NewInt(101)
+	err := AssertSupplyConservation(ctx, top)
+	require.ErrorContains(t, err, "100uatom escrowed on gaia-1 channel transfer/channel-0")
+	require.ErrorContains(t, err, "is 101")
+
+	// Non ICS-20 channels are ignored.
+	link.Channels[0].Version = "ics27-1"
+	require.NoError(t, AssertSupplyConservation(ctx, top))
+}
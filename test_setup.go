@@ -29,6 +29,26 @@ func KeepDockerVolumesOnFailure(b bool) {
 	dockerutil.KeepVolumesOnFailure = b
 }
 
+// CollectArtifactsOnFailure sets the directory into which the logs, inspect output,
+// and configuration files (genesis, config.toml, relayer configs) of every container
+// belonging to a failed test are written during docker cleanup.
+// Each test is written to its own subdirectory of dir.
+//
+// The value is empty by default, which disables collection, but can be initialized
+// by setting the environment variable ICTEST_ARTIFACTS_DIR.
+func CollectArtifactsOnFailure(dir string) {
+	dockerutil.ArtifactsDir = dir
+}
+
+// CollectArtifactsAlways sets whether artifacts are also collected for passing tests.
+// It has no effect unless an artifacts directory is set through CollectArtifactsOnFailure.
+//
+// The value is false by default, but can be initialized to true by setting the
+// environment variable ICTEST_ARTIFACTS_ALWAYS to a non-empty value.
+func CollectArtifactsAlways(b bool) {
+	dockerutil.AlwaysCollectArtifacts = b
+}
+
 // DockerSetup returns a new Docker Client and the ID of a configured network, associated with t.
 //
 // If any part of the setup fails, t.Fatal is called.
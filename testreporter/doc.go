@@ -73,4 +73,13 @@
 //
 // If you use a plain require.NoError(t, err) call,
 // the report will note that the test failed, but the report will not include the error line.
+//
+// A finished report can be loaded back with ReadReport,
+// and rendered as JUnit XML for CI dashboards or as a standalone HTML page:
+//
+//	f, _ := os.Open("/tmp/report.json")
+//	report, err := testreporter.ReadReport(f)
+//	// Handle err...
+//	_ = report.WriteJUnit(junitFile, "interchaintest")
+//	_ = report.WriteHTML(htmlFile, "interchaintest report")
 package testreporter
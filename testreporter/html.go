@@ -0,0 +1,195 @@
+package testreporter
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+// WriteHTML writes r to w as a single self-contained HTML page.
+//
+// Each test is rendered with its status, duration, errors,
+// every relayer and chain command it executed, including stdout, stderr,
+// exit code, and timing, the lifecycle events of its containers, and any metrics snapshots.
+// The page has no external dependencies, so it can be uploaded as a CI artifact as-is.
+func (r *Report) WriteHTML(w io.Writer, title string) error {
+	if err := htmlTemplate.Execute(w, htmlReport{Title: title, Report: r}); err != nil {
+		return fmt.Errorf("failed to render html report: %w", err)
+	}
+	return nil
+}
+
+type htmlReport struct {
+	Title string
+	*Report
+}
+
+// Counts returns the number of tests per status, for the summary header.
+func (r htmlReport) Counts() map[string]int {
+	counts := make(map[string]int, 4)
+	for _, t := range r.Tests {
+		counts[t.Status()]++
+	}
+	return counts
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"join": strings.Join,
+	"since": func(start, end time.Time) string {
+		if start.IsZero() || end.IsZero() {
+			return "-"
+		}
+		return end.Sub(start).Round(time.Millisecond).String()
+	},
+	"dur": func(d time.Duration) string {
+		return d.Round(time.Millisecond).String()
+	},
+	"ts": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.UTC().Format("15:04:05.000")
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292f; }
+h1 { margin-bottom: 0.2em; }
+.summary span { margin-right: 1.5em; }
+details.test { border: 1px solid #d0d7de; border-radius: 6px; margin: 0.5em 0; padding: 0.3em 0.8em; }
+details.test > summary { cursor: pointer; font-weight: 600; }
+.status { display: inline-block; min-width: 6em; padding: 0 0.4em; border-radius: 4px; color: #fff; text-align: center; font-size: 0.85em; }
+.passed { background: #1a7f37; }
+.failed { background: #cf222e; }
+.skipped { background: #6e7781; }
+.incomplete { background: #bf8700; }
+.meta { color: #57606a; font-weight: normal; font-size: 0.9em; margin-left: 0.5em; }
+pre { background: #f6f8fa; padding: 0.6em; overflow-x: auto; white-space: pre-wrap; word-break: break-all; }
+pre.error { background: #ffebe9; }
+table { border-collapse: collapse; width: 100%; }
+td, th { text-align: left; vertical-align: top; padding: 0.3em 0.5em; border-bottom: 1px solid #d0d7de; }
+details.exec > summary { cursor: pointer; font-family: monospace; }
+.nonzero { color: #cf222e; font-weight: 600; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- $counts := .Counts}}
+<p class="summary">
+<span>Started: {{ts .StartedAt}}</span>
+<span>Duration: {{since .StartedAt .FinishedAt}}</span>
+<span>Tests: {{len .Tests}}</span>
+<span>Passed: {{index $counts "passed"}}</span>
+<span>Failed: {{index $counts "failed"}}</span>
+<span>Skipped: {{index $counts "skipped"}}</span>
+<span>Incomplete: {{index $counts "incomplete"}}</span>
+</p>
+{{- range .Tests}}
+<details class="test"{{if or .Failed (not .Finished)}} open{{end}}>
+<summary><span class="status {{.Status}}">{{.Status}}</span> {{.Name}}<span class="meta">{{dur .Duration}}{{if .PausedFor}} (paused {{dur .PausedFor}}){{end}}, {{len .RelayerExecs}} relayer command(s), {{len .ChainExecs}} chain command(s)</span></summary>
+{{- if .SkipReason}}
+<p>Skip reason: {{.SkipReason}}</p>
+{{- end}}
+{{- range .Errors}}
+<pre class="error">[{{ts .When}}] {{.Message}}</pre>
+{{- end}}
+{{- if .RelayerExecs}}
+<h4>Relayer commands</h4>
+<table>
+<tr><th>Started</th><th>Duration</th><th>Exit</th><th>Command</th></tr>
+{{- range .RelayerExecs}}
+<tr>
+<td>{{ts .StartedAt}}</td>
+<td>{{since .StartedAt .FinishedAt}}</td>
+<td{{if or .ExitCode .Error}} class="nonzero"{{end}}>{{.ExitCode}}</td>
+<td>
+<details class="exec">
+<summary>{{join .Command " "}}</summary>
+{{- if .ContainerName}}
+<p>Container: {{.ContainerName}}</p>
+{{- end}}
+{{- if .Error}}
+<pre class="error">{{.Error}}</pre>
+{{- end}}
+<p>stdout:</p>
+<pre>{{.Stdout}}</pre>
+<p>stderr:</p>
+<pre>{{.Stderr}}</pre>
+</details>
+</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .ChainExecs}}
+<h4>Chain commands</h4>
+<table>
+<tr><th>Started</th><th>Duration</th><th>Exit</th><th>Chain</th><th>Command</th></tr>
+{{- range .ChainExecs}}
+<tr>
+<td>{{ts .StartedAt}}</td>
+<td>{{since .StartedAt .FinishedAt}}</td>
+<td{{if or .ExitCode .Error}} class="nonzero"{{end}}>{{.ExitCode}}</td>
+<td>{{.ChainID}}</td>
+<td>
+<details class="exec">
+<summary>{{join .Command " "}}</summary>
+{{- if .Node}}
+<p>Node: {{.Node}}</p>
+{{- end}}
+{{- if .Error}}
+<pre class="error">{{.Error}}</pre>
+{{- end}}
+<p>stdout:</p>
+<pre>{{.Stdout}}</pre>
+<p>stderr:</p>
+<pre>{{.Stderr}}</pre>
+</details>
+</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .ContainerEvents}}
+<h4>Container events</h4>
+<table>
+<tr><th>When</th><th>Event</th><th>Container</th><th>Error</th></tr>
+{{- range .ContainerEvents}}
+<tr>
+<td>{{ts .When}}</td>
+<td{{if .Error}} class="nonzero"{{end}}>{{.Event}}</td>
+<td>{{.ContainerName}}</td>
+<td>{{.Error}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Metrics}}
+<h4>Metrics</h4>
+<table>
+<tr><th>When</th><th>Source</th><th>Samples</th></tr>
+{{- range .Metrics}}
+<tr>
+<td>{{ts .When}}</td>
+<td>{{.Source}}</td>
+<td>
+<details class="exec">
+<summary>{{len .Samples}} sample(s)</summary>
+<pre>{{range $series, $value := .Samples}}{{$series}} {{$value}}
+{{end}}</pre>
+</details>
+</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+</details>
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,147 @@
+package testreporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// junitTestSuites is the root element of a JUnit XML document.
+// Only the subset of the schema understood by common CI dashboards is produced.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit writes r to w as a JUnit XML document.
+//
+// All tests are placed in a single test suite named suiteName.
+// Test errors become the failure body, and relayer and chain commands executed
+// during the test are summarized in the test case's system-out.
+func (r *Report) WriteJUnit(w io.Writer, suiteName string) error {
+	suite := junitTestSuite{
+		Name: suiteName,
+		Time: junitSeconds(r.Duration().Seconds()),
+	}
+	if !r.StartedAt.IsZero() {
+		suite.Timestamp = r.StartedAt.UTC().Format("2006-01-02T15:04:05")
+	}
+
+	for _, t := range r.Tests {
+		tc := junitTestCase{
+			Name:      t.Name,
+			Classname: suiteName,
+			Time:      junitSeconds(t.Duration().Seconds()),
+			SystemOut: junitSystemOut(t),
+		}
+
+		switch t.Status() {
+		case "skipped":
+			tc.Skipped = &junitMessage{Message: t.SkipReason}
+			suite.Skipped++
+		case "failed", "incomplete":
+			tc.Failure = junitFailure(t)
+			suite.Failures++
+		}
+
+		suite.Tests++
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	doc := junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode junit report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitSeconds(s float64) string {
+	return fmt.Sprintf("%.3f", s)
+}
+
+func junitFailure(t *TestReport) *junitMessage {
+	if !t.Finished {
+		return &junitMessage{Message: "test did not finish"}
+	}
+	if len(t.Errors) == 0 {
+		return &junitMessage{Message: "test failed"}
+	}
+
+	var body strings.Builder
+	for i, e := range t.Errors {
+		if i > 0 {
+			body.WriteString("\n\n")
+		}
+		body.WriteString(e.Message)
+	}
+	// The first line of the first error is the most useful summary.
+	msg, _, _ := strings.Cut(strings.TrimSpace(t.Errors[0].Message), "\n")
+	return &junitMessage{Message: msg, Body: body.String()}
+}
+
+func junitSystemOut(t *TestReport) string {
+	var b strings.Builder
+	for _, e := range t.RelayerExecs {
+		junitExec(&b, "relayer", e.Command, e.ExitCode, e.FinishedAt.Sub(e.StartedAt), e.Error, e.Stdout, e.Stderr)
+	}
+	for _, e := range t.ChainExecs {
+		junitExec(&b, e.ChainID, e.Command, e.ExitCode, e.FinishedAt.Sub(e.StartedAt), e.Error, e.Stdout, e.Stderr)
+	}
+	return b.String()
+}
+
+func junitExec(b *strings.Builder, source string, command []string, exitCode int, d time.Duration, errMsg, stdout, stderr string) {
+	fmt.Fprintf(b, "[%s] $ %s (exit %d, %s)\n", source, strings.Join(command, " "), exitCode, d)
+	if errMsg != "" {
+		fmt.Fprintf(b, "error: %s\n", errMsg)
+	}
+	if stdout != "" {
+		fmt.Fprintf(b, "stdout:\n%s\n", strings.TrimRight(stdout, "\n"))
+	}
+	if stderr != "" {
+		fmt.Fprintf(b, "stderr:\n%s\n", strings.TrimRight(stderr, "\n"))
+	}
+}
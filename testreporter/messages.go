@@ -133,6 +133,71 @@ func (m RelayerExecMessage) typ() string {
 	return "RelayerExec"
 }
 
+// ChainExecMessage is the result of executing a command against a chain node,
+// such as a transaction, a query, or a raw invocation of the chain binary.
+// This message is populated through the RelayerExecReporter type,
+// which satisfies the ibc.ChainExecReporter interface.
+type ChainExecMessage struct {
+	Name string // Test name, but "Name" for consistency.
+
+	StartedAt, FinishedAt time.Time
+
+	ChainID string
+
+	// Name of the chain node the command targeted.
+	Node string `json:",omitempty"`
+
+	Command []string
+
+	Stdout, Stderr string
+
+	ExitCode int
+
+	Error string `json:",omitempty"`
+}
+
+func (m ChainExecMessage) typ() string {
+	return "ChainExec"
+}
+
+// ContainerEventMessage records a lifecycle change of a long-running docker container,
+// such as a chain node, sidecar, or relayer being created, started, paused, stopped, or removed.
+// This message is populated through the RelayerExecReporter type,
+// which satisfies the dockerutil.ContainerEventReporter interface.
+type ContainerEventMessage struct {
+	Name string // Test name, but "Name" for consistency.
+	When time.Time
+
+	ContainerName string
+
+	// One of the dockerutil.ContainerEvent constants, e.g. "start" or "stop".
+	Event string
+
+	Error string `json:",omitempty"`
+}
+
+func (m ContainerEventMessage) typ() string {
+	return "ContainerEvent"
+}
+
+// MetricsSnapshotMessage records the Prometheus metrics scraped from one source at one point in time.
+// This message is populated through the RelayerExecReporter type,
+// which satisfies the metrics.Reporter interface.
+type MetricsSnapshotMessage struct {
+	Name string // Test name, but "Name" for consistency.
+	When time.Time
+
+	// The scraped source, e.g. a chain node or relayer name.
+	Source string
+
+	// Sample values keyed by series, e.g. `cometbft_mempool_size{chain_id="gaia-1"}`.
+	Samples map[string]float64
+}
+
+func (m MetricsSnapshotMessage) typ() string {
+	return "MetricsSnapshot"
+}
+
 // WrappedMessage wraps a Message with an outer Type field
 // so that decoders can determine the underlying message's type.
 type WrappedMessage struct {
@@ -199,6 +264,18 @@ func (m *WrappedMessage) UnmarshalJSON(b []byte) error {
 		x := RelayerExecMessage{}
 		err = json.Unmarshal(raw, &x)
 		msg = x
+	case "ChainExec":
+		x := ChainExecMessage{}
+		err = json.Unmarshal(raw, &x)
+		msg = x
+	case "ContainerEvent":
+		x := ContainerEventMessage{}
+		err = json.Unmarshal(raw, &x)
+		msg = x
+	case "MetricsSnapshot":
+		x := MetricsSnapshotMessage{}
+		err = json.Unmarshal(raw, &x)
+		msg = x
 	default:
 		return fmt.Errorf("unknown message type %q", outer.Type)
 	}
@@ -39,6 +39,34 @@ func TestWrappedMessage_RoundTrip(t *testing.T) {
 				Error:         "",
 			},
 		},
+		{
+			Message: testreporter.ChainExecMessage{
+				Name:       "foo",
+				StartedAt:  time.Now(),
+				FinishedAt: time.Now().Add(time.Second),
+				ChainID:    "gaia-1",
+				Node:       "gaia-1-val-0-foo",
+				Command:    []string{"gaiad", "query", "bank", "balances", "cosmos1abc"},
+				Stdout:     `{"balances":[]}`,
+				ExitCode:   0,
+			},
+		},
+		{
+			Message: testreporter.ContainerEventMessage{
+				Name:          "foo",
+				When:          time.Now(),
+				ContainerName: "gaia-1-val-0-foo",
+				Event:         "start",
+			},
+		},
+		{
+			Message: testreporter.MetricsSnapshotMessage{
+				Name:    "foo",
+				When:    time.Now(),
+				Source:  "rly",
+				Samples: map[string]float64{`cosmos_relayer_tx_failure{chain="gaia-1"}`: 0},
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -0,0 +1,179 @@
+package testreporter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Report is the in-memory representation of a stream of messages
+// previously written by a Reporter.
+// Use ReadReport to construct a Report.
+type Report struct {
+	StartedAt, FinishedAt time.Time
+
+	// Tests are ordered by the time each test began.
+	Tests []*TestReport
+}
+
+// TestReport collects every message associated with a single test.
+type TestReport struct {
+	Name string
+
+	StartedAt, FinishedAt time.Time
+
+	// Total time spent paused waiting for parallel execution.
+	PausedFor time.Duration
+
+	Failed, Skipped bool
+
+	// Whether a FinishTestMessage was observed for this test.
+	// If false, the test was likely interrupted by a panic or timeout.
+	Finished bool
+
+	SkipReason string
+
+	Errors []TestErrorMessage
+
+	RelayerExecs []RelayerExecMessage
+
+	ChainExecs []ChainExecMessage
+
+	ContainerEvents []ContainerEventMessage
+
+	Metrics []MetricsSnapshotMessage
+}
+
+// Duration returns the wall time of the test, excluding time spent paused.
+// If the test never finished, Duration returns zero.
+func (t *TestReport) Duration() time.Duration {
+	if !t.Finished {
+		return 0
+	}
+	return t.FinishedAt.Sub(t.StartedAt) - t.PausedFor
+}
+
+// Status returns a short human-readable summary of the test result.
+func (t *TestReport) Status() string {
+	switch {
+	case !t.Finished:
+		return "incomplete"
+	case t.Skipped:
+		return "skipped"
+	case t.Failed:
+		return "failed"
+	default:
+		return "passed"
+	}
+}
+
+// Duration returns the wall time of the entire suite.
+func (r *Report) Duration() time.Duration {
+	if r.FinishedAt.IsZero() {
+		return 0
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// Test returns the TestReport with the given name, or nil if no such test was reported.
+func (r *Report) Test(name string) *TestReport {
+	for _, t := range r.Tests {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// ReadReport decodes every WrappedMessage from rd,
+// as written by a Reporter, and groups them by test.
+//
+// Messages referring to a test that was never begun
+// (for instance because TrackTest was not called)
+// are still collected under a TestReport with that name.
+func ReadReport(rd io.Reader) (*Report, error) {
+	dec := json.NewDecoder(rd)
+
+	var msgs []Message
+	for {
+		var wm WrappedMessage
+		if err := dec.Decode(&wm); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode message %d: %w", len(msgs), err)
+		}
+		msgs = append(msgs, wm.Message)
+	}
+
+	return NewReport(msgs), nil
+}
+
+// NewReport builds a Report out of already-decoded messages.
+func NewReport(msgs []Message) *Report {
+	r := new(Report)
+	byName := make(map[string]*TestReport)
+	pausedAt := make(map[string]time.Time)
+
+	get := func(name string) *TestReport {
+		if t, ok := byName[name]; ok {
+			return t
+		}
+		t := &TestReport{Name: name}
+		byName[name] = t
+		r.Tests = append(r.Tests, t)
+		return t
+	}
+
+	for _, m := range msgs {
+		switch m := m.(type) {
+		case BeginSuiteMessage:
+			r.StartedAt = m.StartedAt
+		case FinishSuiteMessage:
+			r.FinishedAt = m.FinishedAt
+		case BeginTestMessage:
+			get(m.Name).StartedAt = m.StartedAt
+		case FinishTestMessage:
+			t := get(m.Name)
+			t.FinishedAt = m.FinishedAt
+			t.Failed = m.Failed
+			t.Skipped = m.Skipped
+			t.Finished = true
+		case PauseTestMessage:
+			get(m.Name)
+			pausedAt[m.Name] = m.When
+		case ContinueTestMessage:
+			t := get(m.Name)
+			if p, ok := pausedAt[m.Name]; ok {
+				t.PausedFor += m.When.Sub(p)
+				delete(pausedAt, m.Name)
+			}
+		case TestErrorMessage:
+			t := get(m.Name)
+			t.Errors = append(t.Errors, m)
+		case TestSkipMessage:
+			get(m.Name).SkipReason = m.Message
+		case RelayerExecMessage:
+			t := get(m.Name)
+			t.RelayerExecs = append(t.RelayerExecs, m)
+		case ChainExecMessage:
+			t := get(m.Name)
+			t.ChainExecs = append(t.ChainExecs, m)
+		case ContainerEventMessage:
+			t := get(m.Name)
+			t.ContainerEvents = append(t.ContainerEvents, m)
+		case MetricsSnapshotMessage:
+			t := get(m.Name)
+			t.Metrics = append(t.Metrics, m)
+		}
+	}
+
+	sort.SliceStable(r.Tests, func(i, j int) bool {
+		return r.Tests[i].StartedAt.Before(r.Tests[j].StartedAt)
+	})
+
+	return r
+}
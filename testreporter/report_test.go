@@ -0,0 +1,191 @@
+package testreporter_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/mocktesting"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSampleReport runs a passing test, a failing test with a relayer exec,
+// and a skipped test through a Reporter, returning the raw report.
+func writeSampleReport(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	r := testreporter.NewReporter(nopCloser{Writer: buf})
+
+	pass := mocktesting.NewT("TestPass")
+	r.TrackTest(pass)
+	pass.RunCleanups()
+
+	fail := mocktesting.NewT("TestFail")
+	r.TrackTest(fail)
+	start := time.Now()
+	r.RelayerExecReporter(fail).TrackRelayerExec(
+		"relayer-exec-1",
+		[]string{"rly", "tx", "flush"},
+		"flushed <3> packets", "oops",
+		1,
+		start, start.Add(time.Second),
+		errors.New("exit code 1"),
+	)
+	eRep := r.RelayerExecReporter(fail)
+	eRep.TrackChainExec(
+		"gaia-1", "gaia-1-val-0-TestFail",
+		[]string{"gaiad", "query", "bank", "balances", "cosmos1abc"},
+		`{"balances":[]}`, "",
+		0,
+		start, start.Add(2*time.Second),
+		nil,
+	)
+	eRep.TrackContainerEvent("gaia-1-val-0-TestFail", "stop", start.Add(3*time.Second), nil)
+	eRep.TrackMetrics("rly", start.Add(4*time.Second), map[string]float64{`cosmos_relayer_tx_failure{chain="gaia-1"}`: 2})
+	r.TestifyT(fail).Errorf("relay failed\nmore detail")
+	fail.RunCleanups()
+
+	skip := mocktesting.NewT("TestSkip")
+	r.TrackTest(skip)
+	skip.Simulate(func() {
+		r.TrackSkip(skip, "not today")
+	})
+
+	require.NoError(t, r.Close())
+	return buf
+}
+
+func TestReadReport(t *testing.T) {
+	t.Parallel()
+
+	rep, err := testreporter.ReadReport(writeSampleReport(t))
+	require.NoError(t, err)
+
+	require.False(t, rep.StartedAt.IsZero())
+	require.False(t, rep.FinishedAt.IsZero())
+	require.Len(t, rep.Tests, 3)
+
+	pass := rep.Test("TestPass")
+	require.NotNil(t, pass)
+	require.Equal(t, "passed", pass.Status())
+
+	fail := rep.Test("TestFail")
+	require.NotNil(t, fail)
+	require.Equal(t, "failed", fail.Status())
+	require.Len(t, fail.Errors, 1)
+	require.Len(t, fail.RelayerExecs, 1)
+	require.Equal(t, []string{"rly", "tx", "flush"}, fail.RelayerExecs[0].Command)
+	require.Len(t, fail.ChainExecs, 1)
+	require.Equal(t, "gaia-1", fail.ChainExecs[0].ChainID)
+	require.Len(t, fail.ContainerEvents, 1)
+	require.Equal(t, "stop", fail.ContainerEvents[0].Event)
+	require.Len(t, fail.Metrics, 1)
+	require.Equal(t, "rly", fail.Metrics[0].Source)
+
+	skip := rep.Test("TestSkip")
+	require.NotNil(t, skip)
+	require.Equal(t, "skipped", skip.Status())
+	require.Equal(t, "not today", skip.SkipReason)
+
+	require.Nil(t, rep.Test("TestMissing"))
+}
+
+func TestReadReport_Incomplete(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	r := testreporter.NewReporter(nopCloser{Writer: buf})
+	r.TrackTest(mocktesting.NewT("TestHang")) // Cleanups never run.
+	require.NoError(t, r.Close())
+
+	rep, err := testreporter.ReadReport(buf)
+	require.NoError(t, err)
+	require.Len(t, rep.Tests, 1)
+	require.Equal(t, "incomplete", rep.Tests[0].Status())
+	require.Zero(t, rep.Tests[0].Duration())
+}
+
+func TestReadReport_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := testreporter.ReadReport(strings.NewReader(`{"Type":"Bogus","Message":{}}`))
+	require.Error(t, err)
+}
+
+func TestReport_WriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	rep, err := testreporter.ReadReport(writeSampleReport(t))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, rep.WriteJUnit(&out, "interchaintest"))
+
+	var doc struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Skipped  int `xml:"skipped,attr"`
+		Suites   []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Message string `xml:"message,attr"`
+					Body    string `xml:",chardata"`
+				} `xml:"failure"`
+				Skipped *struct {
+					Message string `xml:"message,attr"`
+				} `xml:"skipped"`
+				SystemOut string `xml:"system-out"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &doc))
+
+	require.Equal(t, 3, doc.Tests)
+	require.Equal(t, 1, doc.Failures)
+	require.Equal(t, 1, doc.Skipped)
+	require.Len(t, doc.Suites, 1)
+	require.Equal(t, "interchaintest", doc.Suites[0].Name)
+
+	cases := doc.Suites[0].Cases
+	require.Len(t, cases, 3)
+
+	require.Equal(t, "TestFail", cases[1].Name)
+	require.NotNil(t, cases[1].Failure)
+	require.Equal(t, "relay failed", cases[1].Failure.Message)
+	require.Contains(t, cases[1].Failure.Body, "more detail")
+	require.Contains(t, cases[1].SystemOut, "[relayer] $ rly tx flush (exit 1, 1s)")
+	require.Contains(t, cases[1].SystemOut, "flushed <3> packets")
+	require.Contains(t, cases[1].SystemOut, "[gaia-1] $ gaiad query bank balances cosmos1abc (exit 0, 2s)")
+
+	require.Equal(t, "TestSkip", cases[2].Name)
+	require.NotNil(t, cases[2].Skipped)
+	require.Equal(t, "not today", cases[2].Skipped.Message)
+}
+
+func TestReport_WriteHTML(t *testing.T) {
+	t.Parallel()
+
+	rep, err := testreporter.ReadReport(writeSampleReport(t))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, rep.WriteHTML(&out, "My <Report>"))
+
+	html := out.String()
+	require.Contains(t, html, "<title>My &lt;Report&gt;</title>")
+	require.Contains(t, html, "TestPass")
+	require.Contains(t, html, "rly tx flush")
+	require.Contains(t, html, "flushed &lt;3&gt; packets")
+	require.Contains(t, html, "relayer-exec-1")
+	require.Contains(t, html, "gaiad query bank balances cosmos1abc")
+	require.Contains(t, html, "gaia-1-val-0-TestFail")
+	require.Contains(t, html, "cosmos_relayer_tx_failure{chain=&#34;gaia-1&#34;} 2")
+	require.Contains(t, html, `<span class="status failed">failed</span> TestFail`)
+}
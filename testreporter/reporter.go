@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"time"
 )
 
@@ -156,6 +157,72 @@ func (r *RelayerExecReporter) TrackRelayerExec(
 	}
 }
 
+// TrackChainExec tracks the execution of an individual command against a chain node.
+// This satisfies the ibc.ChainExecReporter interface,
+// so the same RelayerExecReporter passed to Interchain.Build also records chain activity.
+func (r *RelayerExecReporter) TrackChainExec(
+	chainID string,
+	nodeName string,
+	command []string,
+	stdout, stderr string,
+	exitCode int,
+	startedAt, finishedAt time.Time,
+	err error,
+) {
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+	r.r.in <- ChainExecMessage{
+		Name:       r.testName,
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+		ChainID:    chainID,
+		Node:       nodeName,
+		Command:    command,
+		Stdout:     stdout,
+		Stderr:     stderr,
+		ExitCode:   exitCode,
+		Error:      errMsg,
+	}
+}
+
+// TrackContainerEvent tracks a lifecycle event of a long-running container.
+// This satisfies the dockerutil.ContainerEventReporter interface.
+func (r *RelayerExecReporter) TrackContainerEvent(containerName, event string, when time.Time, err error) {
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+	r.r.in <- ContainerEventMessage{
+		Name:          r.testName,
+		When:          when,
+		ContainerName: containerName,
+		Event:         event,
+		Error:         errMsg,
+	}
+}
+
+// TrackMetrics tracks a snapshot of Prometheus metrics scraped from source.
+// This satisfies the metrics.Reporter interface.
+// NaN and infinite samples, such as summaries without observations, are dropped as they cannot be encoded to JSON.
+func (r *RelayerExecReporter) TrackMetrics(source string, when time.Time, samples map[string]float64) {
+	finite := make(map[string]float64, len(samples))
+	for series, v := range samples {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		finite[series] = v
+	}
+
+	r.r.in <- MetricsSnapshotMessage{
+		Name:    r.testName,
+		When:    when,
+		Source:  source,
+		Samples: finite,
+	}
+}
+
 // TestifyT returns a TestifyReporter which will track logged errors in test.
 // Typically you will use this with the New method on the require or assert package:
 //
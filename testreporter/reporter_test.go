@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/strangelove-ventures/interchaintest/v8/metrics"
 	"github.com/strangelove-ventures/interchaintest/v8/mocktesting"
 	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
 	"github.com/stretchr/testify/require"
@@ -259,6 +261,36 @@ func TestReporter_RelayerExec(t *testing.T) {
 	require.Empty(t, diff)
 }
 
+func TestReporter_TrackMetricsNonFinite(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	r := testreporter.NewReporter(nopCloser{Writer: buf})
+
+	mt := mocktesting.NewT("my_test")
+	r.TrackTest(mt)
+
+	// A summary without observations exposes NaN quantiles.
+	snap := metrics.Snapshot{
+		Source: "gaia-1-val-0",
+		When:   time.Now(),
+		Samples: []metrics.Sample{
+			{Name: "cometbft_consensus_height", Value: 10},
+			{Name: "cometbft_p2p_latency", Labels: map[string]string{"quantile": "0.5"}, Value: math.NaN()},
+			{Name: "cometbft_p2p_max", Value: math.Inf(1)},
+		},
+	}
+	r.RelayerExecReporter(mt).TrackMetrics(snap.Source, snap.When, snap.Map())
+
+	mt.RunCleanups()
+
+	require.NoError(t, r.Close())
+
+	msgs := ReporterMessages(t, buf)
+	require.Len(t, msgs, 5)
+	require.Equal(t, map[string]float64{"cometbft_consensus_height": 10}, msgs[2].(testreporter.MetricsSnapshotMessage).Samples)
+}
+
 // requireTimeInRange is a helper to assert that a time occurs between a given start and end.
 func requireTimeInRange(t *testing.T, actual, notBefore, notAfter time.Time) {
 	t.Helper()
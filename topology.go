@@ -0,0 +1,118 @@
+package interchaintest
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// Topology is the graph of chains connected by the links that Build created.
+// Each edge is a Link, holding the client, connection, and channel identifiers on both chains.
+type Topology struct {
+	links []*Link
+}
+
+// NewTopology returns a Topology of the given links.
+// Tests typically use (*Interchain).Topology instead.
+func NewTopology(links ...*Link) *Topology {
+	sorted := append([]*Link(nil), links...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+	return &Topology{links: sorted}
+}
+
+// Topology returns the graph of links created during Build.
+// Links whose identifiers could not be determined are omitted; see Link.
+func (ic *Interchain) Topology() *Topology {
+	ic.linkedMu.Lock()
+	defer ic.linkedMu.Unlock()
+
+	links := make([]*Link, 0, len(ic.linked))
+	for _, l := range ic.linked {
+		links = append(links, l)
+	}
+	return NewTopology(links...)
+}
+
+// Channel returns the channel bound to portID on chainA whose counterparty is on chainB,
+// as seen from chainA; see (*Topology).Channel.
+func (ic *Interchain) Channel(chainA, chainB ibc.Chain, portID string) (ibc.ChannelOutput, error) {
+	return ic.Topology().Channel(chainA, chainB, portID)
+}
+
+// Links returns every link, ordered by path name.
+func (t *Topology) Links() []*Link {
+	return append([]*Link(nil), t.links...)
+}
+
+// LinksOf returns the links with chain on either end.
+func (t *Topology) LinksOf(chain ibc.Chain) []*Link {
+	var out []*Link
+	for _, l := range t.links {
+		if _, ok := l.End(chain); ok {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// Between returns the links connecting chainA and chainB, in either direction.
+func (t *Topology) Between(chainA, chainB ibc.Chain) []*Link {
+	var out []*Link
+	for _, l := range t.links {
+		if cp, ok := l.Counterparty(chainA); ok && cp.Chain == chainB {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// Neighbors returns the chains directly linked to chain, without duplicates.
+func (t *Topology) Neighbors(chain ibc.Chain) []ibc.Chain {
+	var out []ibc.Chain
+	seen := make(map[ibc.Chain]bool)
+	for _, l := range t.links {
+		cp, ok := l.Counterparty(chain)
+		if !ok || seen[cp.Chain] {
+			continue
+		}
+		seen[cp.Chain] = true
+		out = append(out, cp.Chain)
+	}
+	return out
+}
+
+// Channel returns the channel bound to portID on chainA whose counterparty is on chainB, as seen from chainA:
+// its ChannelID is the identifier on chainA, and its Counterparty holds the identifiers on chainB.
+//
+// An error is returned if there is no such channel, or if several links between the chains
+// have one, in which case the channel must be selected through the intended Link.
+func (t *Topology) Channel(chainA, chainB ibc.Chain, portID string) (ibc.ChannelOutput, error) {
+	var (
+		found   ibc.ChannelOutput
+		matches []string
+	)
+	for _, l := range t.Between(chainA, chainB) {
+		if ch, ok := l.ChannelOn(chainA, portID); ok {
+			found = ch
+			matches = append(matches, l.Path)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return ibc.ChannelOutput{}, fmt.Errorf(
+			"no channel on port %s between chains %s and %s",
+			portID, chainA.Config().ChainID, chainB.Config().ChainID,
+		)
+	case 1:
+		return found, nil
+	default:
+		return ibc.ChannelOutput{}, fmt.Errorf(
+			"multiple paths have a channel on port %s between chains %s and %s: %v",
+			portID, chainA.Config().ChainID, chainB.Config().ChainID, matches,
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package interchaintest_test
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTopology(t *testing.T) {
+	cf := interchaintest.NewBuiltinChainFactory(zap.NewNop(), []*interchaintest.ChainSpec{
+		{Name: "gaia", ChainName: "g1", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-0"}},
+		{Name: "gaia", ChainName: "g2", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-1"}},
+		{Name: "gaia", ChainName: "g3", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-2"}},
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+	a, b, c := chains[0], chains[1], chains[2]
+
+	transfer := func(id, counterpartyID string) ibc.ChannelOutput {
+		return ibc.ChannelOutput{
+			PortID:       "transfer",
+			ChannelID:    id,
+			Counterparty: ibc.ChannelCounterparty{PortID: "transfer", ChannelID: counterpartyID},
+		}
+	}
+
+	ab := &interchaintest.Link{
+		Path:     "ab",
+		Chain1:   interchaintest.LinkEnd{Chain: a, ConnectionID: "connection-0"},
+		Chain2:   interchaintest.LinkEnd{Chain: b, ConnectionID: "connection-0"},
+		Channels: []ibc.ChannelOutput{transfer("channel-0", "channel-0")},
+	}
+	cb := &interchaintest.Link{
+		Path:     "bc",
+		Chain1:   interchaintest.LinkEnd{Chain: c, ConnectionID: "connection-0"},
+		Chain2:   interchaintest.LinkEnd{Chain: b, ConnectionID: "connection-1"},
+		Channels: []ibc.ChannelOutput{transfer("channel-0", "channel-1")},
+	}
+	top := interchaintest.NewTopology(cb, ab)
+
+	require.Equal(t, []*interchaintest.Link{ab, cb}, top.Links())
+	require.Equal(t, []*interchaintest.Link{ab, cb}, top.LinksOf(b))
+	require.Equal(t, []*interchaintest.Link{cb}, top.Between(b, c))
+	require.Equal(t, []ibc.Chain{a, c}, top.Neighbors(b))
+	require.Empty(t, top.Between(a, c))
+
+	ch, err := top.Channel(b, c, "transfer")
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", ch.ChannelID)
+	require.Equal(t, "channel-0", ch.Counterparty.ChannelID)
+	require.Equal(t, []string{"connection-1"}, ch.ConnectionHops)
+
+	_, err = top.Channel(a, c, "transfer")
+	require.ErrorContains(t, err, "no channel on port transfer between chains cosmoshub-0 and cosmoshub-2")
+
+	// A second path between a and b makes the lookup ambiguous.
+	ab2 := &interchaintest.Link{
+		Path:     "ab2",
+		Chain1:   interchaintest.LinkEnd{Chain: a, ConnectionID: "connection-1"},
+		Chain2:   interchaintest.LinkEnd{Chain: b, ConnectionID: "connection-2"},
+		Channels: []ibc.ChannelOutput{transfer("channel-1", "channel-2")},
+	}
+	_, err = interchaintest.NewTopology(ab, ab2, cb).Channel(a, b, "transfer")
+	require.ErrorContains(t, err, "multiple paths")
+}